@@ -0,0 +1,90 @@
+package relaychain
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/snowfork/go-substrate-rpc-client/v2/types"
+)
+
+// ParaHead is a single parachain's head as read from the relay chain's Paras.Heads storage map
+// at a given block, with both its still-SCALE-encoded form (as stored on chain, needed to build
+// a Merkle proof that matches the on-chain root byte-for-byte) and its decoded header.
+type ParaHead struct {
+	ParaID        uint32
+	LeafIndex     int
+	EncodedHeader types.Bytes
+	DecodedHeader types.Header
+}
+
+// GetAllParaHeads reads every parachain's head registered in Paras.Heads at blockHash and
+// returns them sorted by ParaID, with LeafIndex set to each head's position in that order.
+// Polkadot's MMR leaf builds MMRLeaf.ParachainHeads as a Merkle root over heads in ParaID order,
+// so a proof generated from any other ordering will not verify against it.
+func (co *Connection) GetAllParaHeads(blockHash types.Hash) ([]ParaHead, error) {
+	// No map key argument: this returns the bare prefix twox_128("Paras") ++ twox_128("Heads"),
+	// which is the root of the Paras.Heads map rather than a specific entry within it.
+	baseParaHeadsStorageKey, err := types.CreateStorageKey(co.GetMetadata(), "Paras", "Heads")
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := co.GetAPI().RPC.State.GetKeys(baseParaHeadsStorageKey, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	changeSets, err := co.GetAPI().RPC.State.QueryStorage(keys, blockHash, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var heads []ParaHead
+	for _, changeSet := range changeSets {
+		for _, change := range changeSet.Changes {
+			paraID, err := decodeParaIDFromStorageKey(change.StorageKey, baseParaHeadsStorageKey)
+			if err != nil {
+				return nil, err
+			}
+
+			var encodedHeader types.Bytes
+			if err := types.DecodeFromBytes(change.StorageData, &encodedHeader); err != nil {
+				return nil, err
+			}
+
+			var decodedHeader types.Header
+			if err := types.DecodeFromBytes(encodedHeader, &decodedHeader); err != nil {
+				return nil, err
+			}
+
+			heads = append(heads, ParaHead{
+				ParaID:        paraID,
+				EncodedHeader: encodedHeader,
+				DecodedHeader: decodedHeader,
+			})
+		}
+	}
+
+	sort.Slice(heads, func(i, j int) bool { return heads[i].ParaID < heads[j].ParaID })
+	for i := range heads {
+		heads[i].LeafIndex = i
+	}
+
+	return heads, nil
+}
+
+// decodeParaIDFromStorageKey recovers the ParaId encoded in a Paras.Heads storage key. The key
+// is basePrefix ++ twox_64_concat(ParaId), i.e. an 8-byte twox_64 hash of the ParaId's SCALE
+// encoding followed by that same encoding, so the ParaId is exactly the key's trailing 4 bytes -
+// no offset guessing into the prefix required.
+func decodeParaIDFromStorageKey(key types.StorageKey, basePrefix types.StorageKey) (uint32, error) {
+	suffix := key[len(basePrefix):]
+	if len(suffix) < 4 {
+		return 0, fmt.Errorf("storage key %s too short to contain a twox_64_concat(ParaId) suffix", key.Hex())
+	}
+	var paraID types.U32
+	if err := types.DecodeFromBytes(suffix[len(suffix)-4:], &paraID); err != nil {
+		return 0, err
+	}
+	return uint32(paraID), nil
+}
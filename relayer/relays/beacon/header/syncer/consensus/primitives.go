@@ -0,0 +1,343 @@
+// Package consensus holds the typed representation of beacon chain data the relay actually
+// computes against: SCALE encoding for the Substrate extrinsic and SSZ hash_tree_root for
+// Merkle-branch verification. The sibling json package models the beacon API's wire format and
+// converts into these types via ToConsensus, so hex validation happens at a single boundary
+// instead of being scattered (or skipped) across every consumer.
+package consensus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/snowfork/go-substrate-rpc-client/v4/scale"
+)
+
+// Bytes32 is a 32-byte hash (a block root, state root, randao reveal, etc).
+type Bytes32 [32]byte
+
+// BLSPubKey is a 48-byte BLS12-381 public key.
+type BLSPubKey [48]byte
+
+// SignatureBytes is a 96-byte BLS12-381 signature.
+type SignatureBytes [96]byte
+
+// Address is a 20-byte execution-layer address.
+type Address [20]byte
+
+// LogsBloom is a 256-byte execution-layer logs bloom filter.
+type LogsBloom [256]byte
+
+// KZGCommitment is a 48-byte KZG polynomial commitment to a blob, the Deneb fork's
+// blob_kzg_commitments entries.
+type KZGCommitment [48]byte
+
+// Hex is an arbitrary-length byte string, used for fields whose length varies, such as
+// extra_data and sync-committee/aggregation bitfields.
+type Hex []byte
+
+func (b Bytes32) MarshalJSON() ([]byte, error) {
+	return marshalFixedHex(b[:])
+}
+
+func (b *Bytes32) UnmarshalJSON(data []byte) error {
+	return unmarshalFixedHex(data, b[:], "Bytes32")
+}
+
+func (b Bytes32) Encode(encoder scale.Encoder) error {
+	return encoder.Write(b[:])
+}
+
+func (b *Bytes32) Decode(decoder scale.Decoder) error {
+	return decoder.Read(b[:])
+}
+
+func (b BLSPubKey) MarshalJSON() ([]byte, error) {
+	return marshalFixedHex(b[:])
+}
+
+func (b *BLSPubKey) UnmarshalJSON(data []byte) error {
+	return unmarshalFixedHex(data, b[:], "BLSPubKey")
+}
+
+func (b BLSPubKey) Encode(encoder scale.Encoder) error {
+	return encoder.Write(b[:])
+}
+
+func (b *BLSPubKey) Decode(decoder scale.Decoder) error {
+	return decoder.Read(b[:])
+}
+
+func (s SignatureBytes) MarshalJSON() ([]byte, error) {
+	return marshalFixedHex(s[:])
+}
+
+func (s *SignatureBytes) UnmarshalJSON(data []byte) error {
+	return unmarshalFixedHex(data, s[:], "SignatureBytes")
+}
+
+func (s SignatureBytes) Encode(encoder scale.Encoder) error {
+	return encoder.Write(s[:])
+}
+
+func (s *SignatureBytes) Decode(decoder scale.Decoder) error {
+	return decoder.Read(s[:])
+}
+
+func (a Address) MarshalJSON() ([]byte, error) {
+	return marshalFixedHex(a[:])
+}
+
+func (a *Address) UnmarshalJSON(data []byte) error {
+	return unmarshalFixedHex(data, a[:], "Address")
+}
+
+func (a Address) Encode(encoder scale.Encoder) error {
+	return encoder.Write(a[:])
+}
+
+func (a *Address) Decode(decoder scale.Decoder) error {
+	return decoder.Read(a[:])
+}
+
+func (k KZGCommitment) MarshalJSON() ([]byte, error) {
+	return marshalFixedHex(k[:])
+}
+
+func (k *KZGCommitment) UnmarshalJSON(data []byte) error {
+	return unmarshalFixedHex(data, k[:], "KZGCommitment")
+}
+
+func (k KZGCommitment) Encode(encoder scale.Encoder) error {
+	return encoder.Write(k[:])
+}
+
+func (k *KZGCommitment) Decode(decoder scale.Decoder) error {
+	return decoder.Read(k[:])
+}
+
+func (l LogsBloom) MarshalJSON() ([]byte, error) {
+	return marshalFixedHex(l[:])
+}
+
+func (l *LogsBloom) UnmarshalJSON(data []byte) error {
+	return unmarshalFixedHex(data, l[:], "LogsBloom")
+}
+
+func (l LogsBloom) Encode(encoder scale.Encoder) error {
+	return encoder.Write(l[:])
+}
+
+func (l *LogsBloom) Decode(decoder scale.Decoder) error {
+	return decoder.Read(l[:])
+}
+
+func (h Hex) MarshalJSON() ([]byte, error) {
+	return marshalFixedHex(h)
+}
+
+func (h *Hex) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	decoded, err := decodeHexString(s, "Hex")
+	if err != nil {
+		return err
+	}
+
+	*h = decoded
+	return nil
+}
+
+func (h Hex) Encode(encoder scale.Encoder) error {
+	return encoder.Encode([]byte(h))
+}
+
+func (h *Hex) Decode(decoder scale.Decoder) error {
+	var b []byte
+	if err := decoder.Decode(&b); err != nil {
+		return err
+	}
+
+	*h = b
+	return nil
+}
+
+// marshalFixedHex renders b as a 0x-prefixed hex JSON string.
+func marshalFixedHex(b []byte) ([]byte, error) {
+	return json.Marshal("0x" + hex.EncodeToString(b))
+}
+
+// unmarshalFixedHex decodes a 0x-prefixed hex JSON string into dst, which must already be sized
+// to the expected byte length; it rejects inputs missing the 0x prefix or of the wrong length,
+// rather than silently truncating or zero-padding them.
+func unmarshalFixedHex(data []byte, dst []byte, typeName string) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	decoded, err := decodeHexString(s, typeName)
+	if err != nil {
+		return err
+	}
+
+	if len(decoded) != len(dst) {
+		return fmt.Errorf("%s: expected %d bytes, got %d", typeName, len(dst), len(decoded))
+	}
+
+	copy(dst, decoded)
+	return nil
+}
+
+// decodeHexString validates that s carries the "0x" prefix the beacon API always uses for byte
+// fields and decodes the remainder.
+func decodeHexString(s string, typeName string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("%s: missing 0x prefix", typeName)
+	}
+
+	decoded, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", typeName, err)
+	}
+
+	return decoded, nil
+}
+
+// DecodeHex hex-decodes a 0x-prefixed string, for callers (such as json.X.ToConsensus methods)
+// that want to attach their own field-path context to a decode error rather than a type name.
+func DecodeHex(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("missing 0x prefix")
+	}
+
+	decoded, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+// DecodeRoot hex-decodes a 0x-prefixed 32-byte field.
+func DecodeRoot(s string) (Bytes32, error) {
+	decoded, err := DecodeHex(s)
+	if err != nil {
+		return Bytes32{}, err
+	}
+	if len(decoded) != 32 {
+		return Bytes32{}, fmt.Errorf("expected 32 bytes, got %d", len(decoded))
+	}
+
+	var out Bytes32
+	copy(out[:], decoded)
+	return out, nil
+}
+
+// DecodeSignature hex-decodes a 0x-prefixed 96-byte BLS signature field.
+func DecodeSignature(s string) (SignatureBytes, error) {
+	decoded, err := DecodeHex(s)
+	if err != nil {
+		return SignatureBytes{}, err
+	}
+	if len(decoded) != 96 {
+		return SignatureBytes{}, fmt.Errorf("expected 96 bytes, got %d", len(decoded))
+	}
+
+	var out SignatureBytes
+	copy(out[:], decoded)
+	return out, nil
+}
+
+// DecodePubKey hex-decodes a 0x-prefixed 48-byte BLS public key field.
+func DecodePubKey(s string) (BLSPubKey, error) {
+	decoded, err := DecodeHex(s)
+	if err != nil {
+		return BLSPubKey{}, err
+	}
+	if len(decoded) != 48 {
+		return BLSPubKey{}, fmt.Errorf("expected 48 bytes, got %d", len(decoded))
+	}
+
+	var out BLSPubKey
+	copy(out[:], decoded)
+	return out, nil
+}
+
+// DecodeAddress hex-decodes a 0x-prefixed 20-byte execution address field.
+func DecodeAddress(s string) (Address, error) {
+	decoded, err := DecodeHex(s)
+	if err != nil {
+		return Address{}, err
+	}
+	if len(decoded) != 20 {
+		return Address{}, fmt.Errorf("expected 20 bytes, got %d", len(decoded))
+	}
+
+	var out Address
+	copy(out[:], decoded)
+	return out, nil
+}
+
+// DecodeKZGCommitment hex-decodes a 0x-prefixed 48-byte KZG commitment field.
+func DecodeKZGCommitment(s string) (KZGCommitment, error) {
+	decoded, err := DecodeHex(s)
+	if err != nil {
+		return KZGCommitment{}, err
+	}
+	if len(decoded) != 48 {
+		return KZGCommitment{}, fmt.Errorf("expected 48 bytes, got %d", len(decoded))
+	}
+
+	var out KZGCommitment
+	copy(out[:], decoded)
+	return out, nil
+}
+
+// DecodeRoots hex-decodes every entry of a Merkle branch (or any other []string of 32-byte
+// roots), rejecting the whole slice if any entry is malformed rather than silently dropping it.
+func DecodeRoots(roots []string) ([]Bytes32, error) {
+	out := make([]Bytes32, len(roots))
+	for i, s := range roots {
+		root, err := DecodeRoot(s)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		out[i] = root
+	}
+	return out, nil
+}
+
+// HashTreeRoot returns b unchanged: a 32-byte Vector[byte, 32] is already exactly one SSZ chunk.
+func (b Bytes32) HashTreeRoot() [32]byte {
+	return b
+}
+
+// HashTreeRoot merkleizes b as the fixed-size Vector[byte, 48] it is in the consensus spec.
+func (b BLSPubKey) HashTreeRoot() [32]byte {
+	return merkleizeChunks(packBytes(b[:]))
+}
+
+// HashTreeRoot merkleizes s as the fixed-size Vector[byte, 96] it is in the consensus spec.
+func (s SignatureBytes) HashTreeRoot() [32]byte {
+	return merkleizeChunks(packBytes(s[:]))
+}
+
+// HashTreeRoot merkleizes a as the fixed-size Vector[byte, 20] it is in the consensus spec.
+func (a Address) HashTreeRoot() [32]byte {
+	return merkleizeChunks(packBytes(a[:]))
+}
+
+// HashTreeRoot merkleizes l as the fixed-size Vector[byte, 256] it is in the consensus spec.
+func (l LogsBloom) HashTreeRoot() [32]byte {
+	return merkleizeChunks(packBytes(l[:]))
+}
+
+// HashTreeRoot merkleizes k as the fixed-size Vector[byte, 48] it is in the consensus spec.
+func (k KZGCommitment) HashTreeRoot() [32]byte {
+	return merkleizeChunks(packBytes(k[:]))
+}
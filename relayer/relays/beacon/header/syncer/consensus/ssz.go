@@ -0,0 +1,613 @@
+package consensus
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// This file computes SSZ hash_tree_root for the beacon types the light client sync protocol
+// proves Merkle branches against, and verifies those branches locally against the canonical
+// generalized indices, so a malicious or buggy beacon endpoint is caught here instead of wasting
+// gas on an on-chain submission that would fail the same check.
+
+// Generalized indices into BeaconState (Altair-onward field ordering), the starting points
+// consensus-specs' light client sync protocol proves its Merkle branches against.
+const (
+	nextSyncCommitteeGIndex         = 55
+	finalizedRootGIndex             = 105
+	blockRootsGIndex                = 37
+	executionPayloadGIndexBellatrix = 25
+	executionPayloadGIndexCapella   = 41
+)
+
+const (
+	syncCommitteeSize          = 512 // SYNC_COMMITTEE_SIZE
+	maxValidatorsPerCommittee  = 2048
+	maxProposerSlashings       = 16
+	maxAttesterSlashings       = 2
+	maxAttestations            = 128
+	maxDeposits                = 16
+	maxVoluntaryExits          = 16
+	maxExtraDataBytes          = 32
+	depositProofDepth          = 33 // DEPOSIT_CONTRACT_TREE_DEPTH + 1
+	maxWithdrawalsPerPayload   = 16
+	maxBLSToExecutionChanges   = 16   // MAX_BLS_TO_EXECUTION_CHANGES
+	maxBlobCommitmentsPerBlock = 4096 // MAX_BLOB_COMMITMENTS_PER_BLOCK
+	// EIP-7685 execution-layer request queue limits.
+	maxDepositRequestsPerPayload       = 8192
+	maxWithdrawalRequestsPerPayload    = 16
+	maxConsolidationRequestsPerPayload = 1
+)
+
+// maxValidatorsPerCommitteeChunks is the packed-chunk capacity of a List[uint64,
+// maxValidatorsPerCommittee], used for IndexedAttestation.AttestingIndices.
+const maxValidatorsPerCommitteeChunks = (maxValidatorsPerCommittee*8 + 31) / 32
+
+// sszHash combines two sibling chunks into their parent, as every step of SSZ merkleization does.
+func sszHash(a, b [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Sum256(buf[:])
+}
+
+// nextPowerOfTwo returns the smallest power of two that is >= n, with nextPowerOfTwo(0) == 1.
+func nextPowerOfTwo(n uint64) uint64 {
+	if n <= 1 {
+		return 1
+	}
+	p := uint64(1)
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// merkleizeChunksWithLimit hashes chunks up a binary Merkle tree padded to limit leaves (rounded
+// up to the next power of two), as SSZ's merkleize(chunks, limit) does for List/Bitlist types.
+func merkleizeChunksWithLimit(chunks [][32]byte, limit uint64) [32]byte {
+	count := nextPowerOfTwo(limit)
+	layer := make([][32]byte, count)
+	copy(layer, chunks)
+
+	for count > 1 {
+		next := make([][32]byte, count/2)
+		for i := range next {
+			next[i] = sszHash(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+		count /= 2
+	}
+
+	return layer[0]
+}
+
+// merkleizeChunks hashes chunks up a binary Merkle tree padded to the next power of two, as SSZ's
+// merkleize(chunks) does for fixed-size Vector/Container types.
+func merkleizeChunks(chunks [][32]byte) [32]byte {
+	return merkleizeChunksWithLimit(chunks, uint64(len(chunks)))
+}
+
+// mixInLength folds a list's element (or bit) count into its merkleized content root, as SSZ
+// requires for every variable-length List/Bitlist type.
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	var lengthChunk [32]byte
+	binary.LittleEndian.PutUint64(lengthChunk[:8], length)
+	return sszHash(root, lengthChunk)
+}
+
+// packBytes splits b into 32-byte chunks, zero-padding the final one, as SSZ's pack() does for
+// byte vectors and lists. An empty input still yields a single zero chunk, matching a zero-limit
+// merkleize.
+func packBytes(b []byte) [][32]byte {
+	chunkCount := (len(b) + 31) / 32
+	if chunkCount == 0 {
+		chunkCount = 1
+	}
+
+	chunks := make([][32]byte, chunkCount)
+	for i := range chunks {
+		end := (i + 1) * 32
+		if end > len(b) {
+			end = len(b)
+		}
+		copy(chunks[i][:], b[i*32:end])
+	}
+
+	return chunks
+}
+
+// packUint64s packs values four-to-a-chunk in little-endian order, as SSZ's pack() does for
+// List/Vector[uint64, N].
+func packUint64s(values []uint64) [][32]byte {
+	chunkCount := (len(values) + 3) / 4
+	if chunkCount == 0 {
+		chunkCount = 1
+	}
+
+	chunks := make([][32]byte, chunkCount)
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(chunks[i/4][(i%4)*8:(i%4)*8+8], v)
+	}
+
+	return chunks
+}
+
+// merkleizeList merkleizes the per-element hash_tree_root of a List[T, limit] of composite T up
+// to limit elements, then mixes in the actual element count.
+func merkleizeList(roots [][32]byte, limit uint64) [32]byte {
+	return mixInLength(merkleizeChunksWithLimit(roots, limit), uint64(len(roots)))
+}
+
+// packedListHashTreeRoot merkleizes chunks already packed via pack() for a List[T, maxElements]
+// of basic type T (e.g. uint64), up to a chunk-count limit derived from maxElements, then mixes
+// in the actual element count (not the chunk count).
+func packedListHashTreeRoot(chunks [][32]byte, elementCount int, limitChunks uint64) [32]byte {
+	return mixInLength(merkleizeChunksWithLimit(chunks, limitChunks), uint64(elementCount))
+}
+
+// bitlistLength returns the number of data bits an SSZ bitlist encodes: it packs its length into
+// the position of the highest set "sentinel" bit in the final byte rather than storing it
+// separately.
+func bitlistLength(b []byte) uint64 {
+	if len(b) == 0 {
+		return 0
+	}
+
+	last := b[len(b)-1]
+	if last == 0 {
+		return 0
+	}
+
+	msb := uint64(bits.Len8(last)) - 1
+	return uint64(len(b)-1)*8 + msb
+}
+
+// bitlistHashTreeRoot computes hash_tree_root for a Bitlist[limitBits], clearing the sentinel bit
+// before packing so it doesn't leak into the data, then mixing in the real bit count.
+func bitlistHashTreeRoot(b []byte, limitBits uint64) [32]byte {
+	length := bitlistLength(b)
+
+	data := make([]byte, len(b))
+	copy(data, b)
+	if len(data) > 0 {
+		last := data[len(data)-1]
+		msb := bits.Len8(last) - 1
+		data[len(data)-1] = last &^ (1 << uint(msb))
+	}
+
+	limitChunks := (limitBits + 255) / 256
+	return mixInLength(merkleizeChunksWithLimit(packBytes(data), limitChunks), length)
+}
+
+// bitvectorHashTreeRoot computes hash_tree_root for a fixed-size Bitvector, packed as plain bytes
+// with no length mixin.
+func bitvectorHashTreeRoot(b []byte) [32]byte {
+	return merkleizeChunks(packBytes(b))
+}
+
+// gindexDepth returns the Merkle tree depth a generalized index implies: the position of its
+// highest set bit.
+func gindexDepth(index uint64) uint64 {
+	depth := uint64(0)
+	for index > 1 {
+		index >>= 1
+		depth++
+	}
+	return depth
+}
+
+// VerifyMerkleBranch checks that leaf, folded bottom-up with branch, produces root at generalized
+// index index (whose bit length determines depth), per consensus-specs' is_valid_merkle_branch.
+// It returns false rather than panicking on a branch of the wrong length, since verification must
+// be safe to run on an untrusted beacon node's response.
+func VerifyMerkleBranch(leaf Bytes32, branch []Bytes32, depth uint64, index uint64, root Bytes32) bool {
+	if uint64(len(branch)) != depth {
+		return false
+	}
+
+	value := [32]byte(leaf)
+	for i := uint64(0); i < depth; i++ {
+		sibling := [32]byte(branch[i])
+		if (index>>i)&1 == 1 {
+			value = sszHash(sibling, value)
+		} else {
+			value = sszHash(value, sibling)
+		}
+	}
+
+	return value == [32]byte(root)
+}
+
+func (c Checkpoint) HashTreeRoot() [32]byte {
+	var epochChunk [32]byte
+	binary.LittleEndian.PutUint64(epochChunk[:8], c.Epoch)
+	return merkleizeChunks([][32]byte{epochChunk, c.Root.HashTreeRoot()})
+}
+
+func (v VoluntaryExit) HashTreeRoot() [32]byte {
+	var epochChunk, indexChunk [32]byte
+	binary.LittleEndian.PutUint64(epochChunk[:8], v.Epoch)
+	binary.LittleEndian.PutUint64(indexChunk[:8], v.ValidatorIndex)
+	return merkleizeChunks([][32]byte{epochChunk, indexChunk})
+}
+
+func (a AttestationData) HashTreeRoot() [32]byte {
+	var slotChunk, indexChunk [32]byte
+	binary.LittleEndian.PutUint64(slotChunk[:8], a.Slot)
+	binary.LittleEndian.PutUint64(indexChunk[:8], a.Index)
+
+	return merkleizeChunks([][32]byte{
+		slotChunk,
+		indexChunk,
+		a.BeaconBlockRoot.HashTreeRoot(),
+		a.Source.HashTreeRoot(),
+		a.Target.HashTreeRoot(),
+	})
+}
+
+func (a Attestation) HashTreeRoot() [32]byte {
+	return merkleizeChunks([][32]byte{
+		bitlistHashTreeRoot(a.AggregationBits, maxValidatorsPerCommittee),
+		a.Data.HashTreeRoot(),
+		a.Signature.HashTreeRoot(),
+	})
+}
+
+func (d DepositData) HashTreeRoot() [32]byte {
+	var amountChunk [32]byte
+	binary.LittleEndian.PutUint64(amountChunk[:8], d.Amount)
+
+	return merkleizeChunks([][32]byte{
+		d.Pubkey.HashTreeRoot(),
+		d.WithdrawalCredentials.HashTreeRoot(),
+		amountChunk,
+		d.Signature.HashTreeRoot(),
+	})
+}
+
+func (d Deposit) HashTreeRoot() [32]byte {
+	proofChunks := make([][32]byte, depositProofDepth)
+	for i, p := range d.Proof {
+		if i >= depositProofDepth {
+			break
+		}
+		proofChunks[i] = p.HashTreeRoot()
+	}
+	proofRoot := merkleizeChunks(proofChunks)
+
+	return merkleizeChunks([][32]byte{proofRoot, d.Data.HashTreeRoot()})
+}
+
+func (b BeaconHeader) HashTreeRoot() [32]byte {
+	var slotChunk, proposerIndexChunk [32]byte
+	binary.LittleEndian.PutUint64(slotChunk[:8], b.Slot)
+	binary.LittleEndian.PutUint64(proposerIndexChunk[:8], b.ProposerIndex)
+
+	return merkleizeChunks([][32]byte{
+		slotChunk,
+		proposerIndexChunk,
+		b.ParentRoot.HashTreeRoot(),
+		b.StateRoot.HashTreeRoot(),
+		b.BodyRoot.HashTreeRoot(),
+	})
+}
+
+func (s SignedHeader) HashTreeRoot() [32]byte {
+	return merkleizeChunks([][32]byte{s.Message.HashTreeRoot(), s.Signature.HashTreeRoot()})
+}
+
+func (p ProposerSlashing) HashTreeRoot() [32]byte {
+	return merkleizeChunks([][32]byte{p.SignedHeader1.HashTreeRoot(), p.SignedHeader2.HashTreeRoot()})
+}
+
+func (i IndexedAttestation) HashTreeRoot() [32]byte {
+	attestingIndicesRoot := packedListHashTreeRoot(
+		packUint64s(i.AttestingIndices), len(i.AttestingIndices), maxValidatorsPerCommitteeChunks,
+	)
+
+	return merkleizeChunks([][32]byte{attestingIndicesRoot, i.Data.HashTreeRoot(), i.Signature.HashTreeRoot()})
+}
+
+func (a AttesterSlashing) HashTreeRoot() [32]byte {
+	return merkleizeChunks([][32]byte{a.Attestation1.HashTreeRoot(), a.Attestation2.HashTreeRoot()})
+}
+
+func (e Eth1Data) HashTreeRoot() [32]byte {
+	var depositCountChunk [32]byte
+	binary.LittleEndian.PutUint64(depositCountChunk[:8], e.DepositCount)
+
+	return merkleizeChunks([][32]byte{e.DepositRoot.HashTreeRoot(), depositCountChunk, e.BlockHash.HashTreeRoot()})
+}
+
+func (s SyncCommittee) HashTreeRoot() [32]byte {
+	pubkeyChunks := make([][32]byte, syncCommitteeSize)
+	for i, pk := range s.Pubkeys {
+		if i >= syncCommitteeSize {
+			break
+		}
+		pubkeyChunks[i] = pk.HashTreeRoot()
+	}
+
+	return merkleizeChunks([][32]byte{merkleizeChunks(pubkeyChunks), s.AggregatePubkey.HashTreeRoot()})
+}
+
+func (s SyncAggregate) HashTreeRoot() [32]byte {
+	return merkleizeChunks([][32]byte{
+		bitvectorHashTreeRoot(s.SyncCommitteeBits),
+		s.SyncCommitteeSignature.HashTreeRoot(),
+	})
+}
+
+func (w Withdrawal) HashTreeRoot() [32]byte {
+	var indexChunk, validatorIndexChunk, amountChunk [32]byte
+	binary.LittleEndian.PutUint64(indexChunk[:8], w.Index)
+	binary.LittleEndian.PutUint64(validatorIndexChunk[:8], w.ValidatorIndex)
+	binary.LittleEndian.PutUint64(amountChunk[:8], w.Amount)
+
+	return merkleizeChunks([][32]byte{indexChunk, validatorIndexChunk, w.Address.HashTreeRoot(), amountChunk})
+}
+
+// WithdrawalsRoot computes the SSZ root of a List[Withdrawal, maxWithdrawalsPerPayload], for
+// json.ExecutionPayloadCapella/Deneb's ToConsensus to populate ExecutionPayload.WithdrawalsRoot.
+func WithdrawalsRoot(withdrawals []Withdrawal) Bytes32 {
+	roots := make([][32]byte, len(withdrawals))
+	for i, w := range withdrawals {
+		roots[i] = w.HashTreeRoot()
+	}
+	return merkleizeList(roots, maxWithdrawalsPerPayload)
+}
+
+func (b BLSToExecutionChange) HashTreeRoot() [32]byte {
+	var validatorIndexChunk [32]byte
+	binary.LittleEndian.PutUint64(validatorIndexChunk[:8], b.ValidatorIndex)
+
+	return merkleizeChunks([][32]byte{validatorIndexChunk, b.FromBLSPubkey.HashTreeRoot(), b.ToExecutionAddress.HashTreeRoot()})
+}
+
+func (s SignedBLSToExecutionChange) HashTreeRoot() [32]byte {
+	return merkleizeChunks([][32]byte{s.Message.HashTreeRoot(), s.Signature.HashTreeRoot()})
+}
+
+// BLSToExecutionChangesRoot computes the SSZ root of a
+// List[SignedBLSToExecutionChange, maxBLSToExecutionChanges], for json.BlockBodyCapella/Deneb/
+// Electra's ToConsensus to populate BlockBody.BLSToExecutionChangesRoot.
+func BLSToExecutionChangesRoot(changes []SignedBLSToExecutionChange) Bytes32 {
+	roots := make([][32]byte, len(changes))
+	for i, c := range changes {
+		roots[i] = c.HashTreeRoot()
+	}
+	return merkleizeList(roots, maxBLSToExecutionChanges)
+}
+
+// BlobKZGCommitmentsRoot computes the SSZ root of a
+// List[KZGCommitment, maxBlobCommitmentsPerBlock], for json.BlockBodyDeneb/Electra's ToConsensus
+// to populate BlockBody.BlobKZGCommitmentsRoot.
+func BlobKZGCommitmentsRoot(commitments []KZGCommitment) Bytes32 {
+	roots := make([][32]byte, len(commitments))
+	for i, c := range commitments {
+		roots[i] = c.HashTreeRoot()
+	}
+	return merkleizeList(roots, maxBlobCommitmentsPerBlock)
+}
+
+func (d DepositRequest) HashTreeRoot() [32]byte {
+	var amountChunk, indexChunk [32]byte
+	binary.LittleEndian.PutUint64(amountChunk[:8], d.Amount)
+	binary.LittleEndian.PutUint64(indexChunk[:8], d.Index)
+
+	return merkleizeChunks([][32]byte{
+		d.Pubkey.HashTreeRoot(),
+		d.WithdrawalCredentials.HashTreeRoot(),
+		amountChunk,
+		d.Signature.HashTreeRoot(),
+		indexChunk,
+	})
+}
+
+func (w WithdrawalRequest) HashTreeRoot() [32]byte {
+	var amountChunk [32]byte
+	binary.LittleEndian.PutUint64(amountChunk[:8], w.Amount)
+
+	return merkleizeChunks([][32]byte{w.SourceAddress.HashTreeRoot(), w.ValidatorPubkey.HashTreeRoot(), amountChunk})
+}
+
+func (c ConsolidationRequest) HashTreeRoot() [32]byte {
+	return merkleizeChunks([][32]byte{c.SourceAddress.HashTreeRoot(), c.SourcePubkey.HashTreeRoot(), c.TargetPubkey.HashTreeRoot()})
+}
+
+// HashTreeRoot computes the ExecutionRequests container root: three list roots, one per EIP-7685
+// request queue.
+func (e ExecutionRequests) HashTreeRoot() [32]byte {
+	depositRoots := make([][32]byte, len(e.Deposits))
+	for i, d := range e.Deposits {
+		depositRoots[i] = d.HashTreeRoot()
+	}
+
+	withdrawalRoots := make([][32]byte, len(e.Withdrawals))
+	for i, w := range e.Withdrawals {
+		withdrawalRoots[i] = w.HashTreeRoot()
+	}
+
+	consolidationRoots := make([][32]byte, len(e.Consolidations))
+	for i, c := range e.Consolidations {
+		consolidationRoots[i] = c.HashTreeRoot()
+	}
+
+	return merkleizeChunks([][32]byte{
+		merkleizeList(depositRoots, maxDepositRequestsPerPayload),
+		merkleizeList(withdrawalRoots, maxWithdrawalRequestsPerPayload),
+		merkleizeList(consolidationRoots, maxConsolidationRequestsPerPayload),
+	})
+}
+
+// HashTreeRoot computes the ExecutionPayload container root. Its field count grows with e.Fork:
+// Bellatrix stops at transactions_root, Capella appends withdrawals_root, and Deneb appends
+// blob_gas_used/excess_blob_gas on top of that (Electra leaves the payload itself unchanged from
+// Deneb - it only adds execution_requests alongside it in BeaconBlockBody).
+func (e ExecutionPayload) HashTreeRoot() [32]byte {
+	var blockNumberChunk, gasLimitChunk, gasUsedChunk, timestampChunk, baseFeeChunk [32]byte
+	binary.LittleEndian.PutUint64(blockNumberChunk[:8], e.BlockNumber)
+	binary.LittleEndian.PutUint64(gasLimitChunk[:8], e.GasLimit)
+	binary.LittleEndian.PutUint64(gasUsedChunk[:8], e.GasUsed)
+	binary.LittleEndian.PutUint64(timestampChunk[:8], e.Timestamp)
+	binary.LittleEndian.PutUint64(baseFeeChunk[:8], e.BaseFeePerGas)
+
+	extraDataChunk := mixInLength(
+		merkleizeChunksWithLimit(packBytes(e.ExtraData), (maxExtraDataBytes+31)/32),
+		uint64(len(e.ExtraData)),
+	)
+
+	chunks := [][32]byte{
+		e.ParentHash.HashTreeRoot(),
+		e.FeeRecipient.HashTreeRoot(),
+		e.StateRoot.HashTreeRoot(),
+		e.ReceiptsRoot.HashTreeRoot(),
+		e.LogsBloom.HashTreeRoot(),
+		e.PrevRandao.HashTreeRoot(),
+		blockNumberChunk,
+		gasLimitChunk,
+		gasUsedChunk,
+		timestampChunk,
+		extraDataChunk,
+		baseFeeChunk,
+		e.BlockHash.HashTreeRoot(),
+		e.TransactionRoot.HashTreeRoot(),
+	}
+
+	if e.Fork == ForkCapella || e.Fork == ForkDeneb || e.Fork == ForkElectra {
+		chunks = append(chunks, e.WithdrawalsRoot.HashTreeRoot())
+	}
+
+	if e.Fork == ForkDeneb || e.Fork == ForkElectra {
+		var blobGasUsedChunk, excessBlobGasChunk [32]byte
+		binary.LittleEndian.PutUint64(blobGasUsedChunk[:8], e.BlobGasUsed)
+		binary.LittleEndian.PutUint64(excessBlobGasChunk[:8], e.ExcessBlobGas)
+		chunks = append(chunks, blobGasUsedChunk, excessBlobGasChunk)
+	}
+
+	return merkleizeChunks(chunks)
+}
+
+// HashTreeRoot computes the BeaconBlockBody container root. Its field count grows with b.Fork:
+// Capella appends bls_to_execution_changes after sync_aggregate/execution_payload, Deneb appends
+// blob_kzg_commitments, and Electra appends execution_requests.
+func (b BlockBody) HashTreeRoot() [32]byte {
+	proposerSlashingRoots := make([][32]byte, len(b.ProposerSlashings))
+	for i, p := range b.ProposerSlashings {
+		proposerSlashingRoots[i] = p.HashTreeRoot()
+	}
+
+	attesterSlashingRoots := make([][32]byte, len(b.AttesterSlashings))
+	for i, a := range b.AttesterSlashings {
+		attesterSlashingRoots[i] = a.HashTreeRoot()
+	}
+
+	attestationRoots := make([][32]byte, len(b.Attestations))
+	for i, a := range b.Attestations {
+		attestationRoots[i] = a.HashTreeRoot()
+	}
+
+	depositRoots := make([][32]byte, len(b.Deposits))
+	for i, d := range b.Deposits {
+		depositRoots[i] = d.HashTreeRoot()
+	}
+
+	voluntaryExitRoots := make([][32]byte, len(b.VoluntaryExits))
+	for i, v := range b.VoluntaryExits {
+		voluntaryExitRoots[i] = v.HashTreeRoot()
+	}
+
+	chunks := [][32]byte{
+		b.RandaoReveal.HashTreeRoot(),
+		b.Eth1Data.HashTreeRoot(),
+		b.Graffiti.HashTreeRoot(),
+		merkleizeList(proposerSlashingRoots, maxProposerSlashings),
+		merkleizeList(attesterSlashingRoots, maxAttesterSlashings),
+		merkleizeList(attestationRoots, maxAttestations),
+		merkleizeList(depositRoots, maxDeposits),
+		merkleizeList(voluntaryExitRoots, maxVoluntaryExits),
+		b.SyncAggregate.HashTreeRoot(),
+		b.ExecutionPayload.HashTreeRoot(),
+	}
+
+	if b.Fork == ForkCapella || b.Fork == ForkDeneb || b.Fork == ForkElectra {
+		chunks = append(chunks, b.BLSToExecutionChangesRoot.HashTreeRoot())
+	}
+	if b.Fork == ForkDeneb || b.Fork == ForkElectra {
+		chunks = append(chunks, b.BlobKZGCommitmentsRoot.HashTreeRoot())
+	}
+	if b.Fork == ForkElectra {
+		chunks = append(chunks, b.ExecutionRequestsRoot.HashTreeRoot())
+	}
+
+	return merkleizeChunks(chunks)
+}
+
+func (b Block) HashTreeRoot() [32]byte {
+	var slotChunk, proposerIndexChunk [32]byte
+	binary.LittleEndian.PutUint64(slotChunk[:8], b.Slot)
+	binary.LittleEndian.PutUint64(proposerIndexChunk[:8], b.ProposerIndex)
+
+	return merkleizeChunks([][32]byte{
+		slotChunk, proposerIndexChunk, b.ParentRoot.HashTreeRoot(), b.StateRoot.HashTreeRoot(), b.Body.HashTreeRoot(),
+	})
+}
+
+// Verify checks this update's next-sync-committee and finality Merkle branches against the
+// attested header's state root, and its block-roots branch against the finalized header's state
+// root, using the canonical Altair generalized indices. It rejects a malformed or invalid update
+// outright, so a malicious beacon endpoint can't get the relay to waste gas submitting it
+// on-chain. It does not verify the sync committee's BLS signature over the attested header;
+// genesisValidatorsRoot is accepted here only because that separate check needs it to derive the
+// signing domain.
+func (s *SyncCommitteeUpdate) Verify(genesisValidatorsRoot Bytes32) bool {
+	if !VerifyMerkleBranch(s.NextSyncCommittee.HashTreeRoot(), s.NextSyncCommitteeBranch, gindexDepth(nextSyncCommitteeGIndex), nextSyncCommitteeGIndex, s.AttestedHeader.StateRoot) {
+		return false
+	}
+
+	if !VerifyMerkleBranch(s.FinalizedHeader.HashTreeRoot(), s.FinalityBranch, gindexDepth(finalizedRootGIndex), finalizedRootGIndex, s.AttestedHeader.StateRoot) {
+		return false
+	}
+
+	return VerifyMerkleBranch(s.BlockRootsRoot, s.BlockRootBranch, gindexDepth(blockRootsGIndex), blockRootsGIndex, s.FinalizedHeader.StateRoot)
+}
+
+// Verify checks this update's finality Merkle branch against the attested header's state root,
+// and its block-roots branch against the finalized header's state root. See
+// (*SyncCommitteeUpdate).Verify for why genesisValidatorsRoot is accepted but unused here.
+func (f *FinalizedHeaderUpdate) Verify(genesisValidatorsRoot Bytes32) bool {
+	if !VerifyMerkleBranch(f.FinalizedHeader.HashTreeRoot(), f.FinalityBranch, gindexDepth(finalizedRootGIndex), finalizedRootGIndex, f.AttestedHeader.StateRoot) {
+		return false
+	}
+
+	return VerifyMerkleBranch(f.BlockRootsRoot, f.BlockRootBranch, gindexDepth(blockRootsGIndex), blockRootsGIndex, f.FinalizedHeader.StateRoot)
+}
+
+// executionPayloadGIndex returns the generalized index execution_payload sits at within
+// BeaconBlockBody for the given fork: Bellatrix and Altair share one index, and Capella onward
+// share another (bls_to_execution_changes and later additions land after execution_payload, so
+// they don't move it further). An unrecognised or zero-value fork is treated as Bellatrix, matching
+// HeaderUpdate's pre-fork-awareness behaviour.
+func executionPayloadGIndex(fork Fork) uint64 {
+	switch fork {
+	case ForkCapella, ForkDeneb, ForkElectra:
+		return executionPayloadGIndexCapella
+	default:
+		return executionPayloadGIndexBellatrix
+	}
+}
+
+// Verify checks this update's execution branch against the beacon header's body root, using the
+// generalized index its execution header's fork implies, and its block-roots branch against the
+// same header's state root. See (*SyncCommitteeUpdate).Verify for why genesisValidatorsRoot is
+// accepted but unused here.
+func (h *HeaderUpdate) Verify(genesisValidatorsRoot Bytes32) bool {
+	gindex := executionPayloadGIndex(h.ExecutionHeader.Fork)
+	if !VerifyMerkleBranch(h.ExecutionHeader.HashTreeRoot(), h.ExecutionBranch, gindexDepth(gindex), gindex, h.BeaconHeader.BodyRoot) {
+		return false
+	}
+
+	return VerifyMerkleBranch(h.BlockRootBranchHeaderRoot, h.BlockRootBranch, gindexDepth(blockRootsGIndex), blockRootsGIndex, h.BeaconHeader.StateRoot)
+}
@@ -0,0 +1,256 @@
+package consensus
+
+// The types in this file mirror the wire structs in the sibling json package field-for-field,
+// but with every hash/pubkey/signature/address field stored as its validated typed-byte form
+// instead of a hex string. json.X.ToConsensus() is the only place that parses hex into these
+// types; everything downstream (SCALE encoding, SSZ hash_tree_root, Merkle verification) can
+// then assume it's already well-formed.
+
+type BeaconHeader struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    Bytes32
+	StateRoot     Bytes32
+	BodyRoot      Bytes32
+}
+
+type SyncCommittee struct {
+	Pubkeys         []BLSPubKey
+	AggregatePubkey BLSPubKey
+}
+
+type SyncAggregate struct {
+	SyncCommitteeBits      Hex
+	SyncCommitteeSignature SignatureBytes
+}
+
+type SignedHeader struct {
+	Message   BeaconHeader
+	Signature SignatureBytes
+}
+
+type Checkpoint struct {
+	Epoch uint64
+	Root  Bytes32
+}
+
+type AttestationData struct {
+	Slot            uint64
+	Index           uint64
+	BeaconBlockRoot Bytes32
+	Source          Checkpoint
+	Target          Checkpoint
+}
+
+type Attestation struct {
+	AggregationBits Hex
+	Data            AttestationData
+	Signature       SignatureBytes
+}
+
+type IndexedAttestation struct {
+	AttestingIndices []uint64
+	Data             AttestationData
+	Signature        SignatureBytes
+}
+
+type ProposerSlashing struct {
+	SignedHeader1 SignedHeader
+	SignedHeader2 SignedHeader
+}
+
+type AttesterSlashing struct {
+	Attestation1 IndexedAttestation
+	Attestation2 IndexedAttestation
+}
+
+type Eth1Data struct {
+	DepositRoot  Bytes32
+	DepositCount uint64
+	BlockHash    Bytes32
+}
+
+type DepositData struct {
+	Pubkey                BLSPubKey
+	WithdrawalCredentials Bytes32
+	Amount                uint64
+	Signature             SignatureBytes
+}
+
+type Deposit struct {
+	Proof []Bytes32
+	Data  DepositData
+}
+
+type VoluntaryExit struct {
+	Epoch          uint64
+	ValidatorIndex uint64
+}
+
+// Fork identifies which beacon chain fork an ExecutionPayload/HeaderUpdate was fetched under,
+// using the same version strings the beacon API tags its responses with (see
+// json.VersionedBlock). ExecutionPayload.HashTreeRoot and HeaderUpdate.Verify both branch on it:
+// the payload container gained withdrawals_root at Capella and blob_gas_used/excess_blob_gas at
+// Deneb, and its generalized index within BeaconBlockBody moved at the same time Capella added
+// bls_to_execution_changes.
+type Fork string
+
+const (
+	ForkBellatrix Fork = "bellatrix"
+	ForkCapella   Fork = "capella"
+	ForkDeneb     Fork = "deneb"
+	ForkElectra   Fork = "electra"
+)
+
+type ExecutionPayload struct {
+	Fork            Fork
+	ParentHash      Bytes32
+	FeeRecipient    Address
+	StateRoot       Bytes32
+	ReceiptsRoot    Bytes32
+	LogsBloom       LogsBloom
+	PrevRandao      Bytes32
+	BlockNumber     uint64
+	GasLimit        uint64
+	GasUsed         uint64
+	Timestamp       uint64
+	ExtraData       Hex
+	BaseFeePerGas   uint64
+	BlockHash       Bytes32
+	TransactionRoot Bytes32
+	// WithdrawalsRoot is unset (zero) before Capella. json.ExecutionPayloadCapella/Deneb's
+	// ToConsensus computes it from the wire Withdrawals list via WithdrawalsRoot (ssz.go), so
+	// nothing downstream needs to walk the list itself, only this precomputed SSZ root.
+	WithdrawalsRoot Bytes32
+	// BlobGasUsed and ExcessBlobGas are unset (zero) before Deneb.
+	BlobGasUsed   uint64
+	ExcessBlobGas uint64
+}
+
+// Withdrawal is a validator withdrawal surfaced through ExecutionPayload from Capella onward.
+type Withdrawal struct {
+	Index          uint64
+	ValidatorIndex uint64
+	Address        Address
+	Amount         uint64
+}
+
+// BLSToExecutionChange is a validator's one-time switch of its withdrawal credentials from a BLS
+// key to an execution address, surfaced through BlockBody from Capella onward.
+type BLSToExecutionChange struct {
+	ValidatorIndex     uint64
+	FromBLSPubkey      BLSPubKey
+	ToExecutionAddress Address
+}
+
+type SignedBLSToExecutionChange struct {
+	Message   BLSToExecutionChange
+	Signature SignatureBytes
+}
+
+// DepositRequest is an EIP-6110 deposit surfaced through the execution payload, present in
+// BlockBody.ExecutionRequests from Electra onward.
+type DepositRequest struct {
+	Pubkey                BLSPubKey
+	WithdrawalCredentials Bytes32
+	Amount                uint64
+	Signature             SignatureBytes
+	Index                 uint64
+}
+
+// WithdrawalRequest is an EIP-7002 execution-layer triggered withdrawal request.
+type WithdrawalRequest struct {
+	SourceAddress   Address
+	ValidatorPubkey BLSPubKey
+	Amount          uint64
+}
+
+// ConsolidationRequest is an EIP-7251 request to consolidate one validator into another.
+type ConsolidationRequest struct {
+	SourceAddress Address
+	SourcePubkey  BLSPubKey
+	TargetPubkey  BLSPubKey
+}
+
+// ExecutionRequests holds the three EIP-7685 execution-layer request queues Electra added to
+// BlockBody.
+type ExecutionRequests struct {
+	Deposits       []DepositRequest
+	Withdrawals    []WithdrawalRequest
+	Consolidations []ConsolidationRequest
+}
+
+type BlockBody struct {
+	Fork              Fork
+	RandaoReveal      SignatureBytes
+	Eth1Data          Eth1Data
+	Graffiti          Bytes32
+	ProposerSlashings []ProposerSlashing
+	AttesterSlashings []AttesterSlashing
+	Attestations      []Attestation
+	Deposits          []Deposit
+	VoluntaryExits    []VoluntaryExit
+	SyncAggregate     SyncAggregate
+	ExecutionPayload  ExecutionPayload
+	// BLSToExecutionChangesRoot is unset (zero) before Capella. BLSToExecutionChanges aren't
+	// modelled here since nothing downstream needs to walk the list, only its precomputed SSZ
+	// root - the same approach ExecutionPayload takes for WithdrawalsRoot.
+	BLSToExecutionChangesRoot Bytes32
+	// BlobKZGCommitmentsRoot is unset (zero) before Deneb.
+	BlobKZGCommitmentsRoot Bytes32
+	// ExecutionRequestsRoot is unset (zero) before Electra.
+	ExecutionRequestsRoot Bytes32
+}
+
+type Block struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    Bytes32
+	StateRoot     Bytes32
+	Body          BlockBody
+}
+
+// SyncCommitteeUpdate, FinalizedHeaderUpdate and HeaderUpdate mirror their json-package
+// namesakes, carrying whatever Merkle branch the update proves plus the header(s) it proves it
+// against, all already typed so Verify can walk them without decoding anything itself.
+
+type SyncCommitteeUpdate struct {
+	AttestedHeader          BeaconHeader
+	NextSyncCommittee       SyncCommittee
+	NextSyncCommitteeBranch []Bytes32
+	FinalizedHeader         BeaconHeader
+	FinalityBranch          []Bytes32
+	SyncAggregate           SyncAggregate
+	SyncCommitteePeriod     uint64
+	SignatureSlot           uint64
+	BlockRootsRoot          Bytes32
+	BlockRootBranch         []Bytes32
+}
+
+type FinalizedHeaderUpdate struct {
+	AttestedHeader  BeaconHeader
+	FinalizedHeader BeaconHeader
+	FinalityBranch  []Bytes32
+	SyncAggregate   SyncAggregate
+	SignatureSlot   uint64
+	BlockRootsRoot  Bytes32
+	BlockRootBranch []Bytes32
+}
+
+type InitialSync struct {
+	Header                     BeaconHeader
+	CurrentSyncCommittee       SyncCommittee
+	CurrentSyncCommitteeBranch []Bytes32
+	ValidatorsRoot             Bytes32
+	ImportTime                 uint64
+}
+
+type HeaderUpdate struct {
+	BeaconHeader              BeaconHeader
+	ExecutionHeader           ExecutionPayload
+	ExecutionBranch           []Bytes32
+	SyncAggregate             SyncAggregate
+	SignatureSlot             uint64
+	BlockRootBranch           []Bytes32
+	BlockRootBranchHeaderRoot Bytes32
+}
@@ -0,0 +1,333 @@
+package syncer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	beaconjson "github.com/snowfork/snowbridge/relayer/relays/beacon/header/syncer/json"
+)
+
+// eventStreamTopics are the SSE topics EventStream subscribes to: head and finalized_checkpoint
+// let the relay trigger a HeaderUpdate/FinalizedHeaderUpdate fetch the moment they fire instead
+// of polling /eth/v1/beacon/headers, block_gossip surfaces a block before it becomes head, the
+// two slashing topics let the relay forward evidence to the Substrate side for validator-set
+// punishment, and bls_to_execution_change surfaces withdrawal-credential changes.
+const eventStreamTopics = "head,finalized_checkpoint,block_gossip,attester_slashing,proposer_slashing,bls_to_execution_change"
+
+// defaultReconnectBackoff and defaultMaxReconnectBackoff bound the exponential backoff
+// EventStream applies between reconnect attempts after its SSE connection drops.
+const (
+	defaultReconnectBackoff    = 1 * time.Second
+	defaultMaxReconnectBackoff = 30 * time.Second
+)
+
+// defaultEventBufferSize is how many events EventStream will buffer on its output channel
+// before a slow consumer makes it block.
+const defaultEventBufferSize = 256
+
+// EventType identifies which field of Event is populated.
+type EventType string
+
+const (
+	EventTypeHead                 EventType = "head"
+	EventTypeFinalizedCheckpoint  EventType = "finalized_checkpoint"
+	EventTypeBlockGossip          EventType = "block_gossip"
+	EventTypeAttesterSlashing     EventType = "attester_slashing"
+	EventTypeProposerSlashing     EventType = "proposer_slashing"
+	EventTypeBLSToExecutionChange EventType = "bls_to_execution_change"
+)
+
+// HeadEvent is the payload of a "head" SSE event: a new head block has been imported.
+type HeadEvent struct {
+	Slot                uint64 `json:"slot"`
+	Block               string `json:"block"`
+	State               string `json:"state"`
+	EpochTransition     bool   `json:"epoch_transition"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// FinalizedCheckpointEvent is the payload of a "finalized_checkpoint" SSE event.
+type FinalizedCheckpointEvent struct {
+	Block string `json:"block"`
+	State string `json:"state"`
+	Epoch uint64 `json:"epoch"`
+}
+
+// BlockGossipEvent is the payload of a "block_gossip" SSE event: a block has been seen on the
+// p2p network, ahead of it becoming head.
+type BlockGossipEvent struct {
+	Slot  uint64 `json:"slot"`
+	Block string `json:"block"`
+}
+
+// ProposerSlashingEvent is the payload of a "proposer_slashing" SSE event.
+type ProposerSlashingEvent struct {
+	beaconjson.ProposerSlashing
+}
+
+// AttesterSlashingEvent is the payload of an "attester_slashing" SSE event.
+type AttesterSlashingEvent struct {
+	beaconjson.AttesterSlashing
+}
+
+// BLSToExecutionChangeEvent is the payload of a "bls_to_execution_change" SSE event.
+type BLSToExecutionChangeEvent struct {
+	beaconjson.SignedBLSToExecutionChange
+}
+
+// Event is a single typed SSE event published on EventStream's output channel. Exactly one of
+// the payload fields is populated, the one named by Type.
+type Event struct {
+	Type                 EventType
+	Head                 *HeadEvent
+	FinalizedCheckpoint  *FinalizedCheckpointEvent
+	BlockGossip          *BlockGossipEvent
+	AttesterSlashing     *AttesterSlashingEvent
+	ProposerSlashing     *ProposerSlashingEvent
+	BLSToExecutionChange *BLSToExecutionChangeEvent
+}
+
+// slot returns the slot e carries, for the event types that carry one, so Start can advance
+// lastSeenSlot without a type switch at every call site.
+func (e *Event) slot() (uint64, bool) {
+	switch e.Type {
+	case EventTypeHead:
+		return e.Head.Slot, true
+	case EventTypeBlockGossip:
+		return e.BlockGossip.Slot, true
+	}
+	return 0, false
+}
+
+// ReplayFunc backfills events for slots missed while EventStream was disconnected, starting
+// from the stream's last-seen slot. Start pushes whatever it returns onto the output channel
+// before resuming live events.
+type ReplayFunc func(ctx context.Context, fromSlot uint64) ([]*Event, error)
+
+// EventStream subscribes to a beacon node's /eth/v1/events SSE endpoint and publishes head,
+// finalized_checkpoint, block_gossip and slashing events on a channel, so the relay can react to
+// a new finalized checkpoint the moment it fires instead of polling for it.
+type EventStream struct {
+	endpoint   string
+	httpClient *http.Client
+	replay     ReplayFunc
+	events     chan *Event
+
+	lastSeenSlot uint64 // accessed via atomic
+}
+
+// NewEventStream constructs an EventStream against the given beacon node HTTP endpoint (e.g.
+// "http://localhost:5052"). httpClient may be nil, in which case http.DefaultClient is used.
+// replay may be nil, in which case EventStream does not attempt to backfill the gap left by a
+// dropped connection.
+func NewEventStream(endpoint string, httpClient *http.Client, replay ReplayFunc) *EventStream {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &EventStream{
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		httpClient: httpClient,
+		replay:     replay,
+		events:     make(chan *Event, defaultEventBufferSize),
+	}
+}
+
+// Events returns the channel EventStream publishes parsed events on. It is closed once Start's
+// context is cancelled.
+func (e *EventStream) Events() <-chan *Event {
+	return e.events
+}
+
+// Start opens the SSE connection and runs until ctx is cancelled, reconnecting with exponential
+// backoff whenever the connection drops or fails to open, and invoking replay (if set) to
+// backfill whatever slots were missed across a reconnect before resuming live events.
+func (e *EventStream) Start(ctx context.Context, eg *errgroup.Group) error {
+	eg.Go(func() error {
+		defer close(e.events)
+
+		backoff := defaultReconnectBackoff
+		for {
+			connected, err := e.consume(ctx)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				log.WithError(err).Warn("Beacon event stream disconnected")
+			}
+
+			if connected {
+				backoff = defaultReconnectBackoff
+			} else {
+				backoff *= 2
+				if backoff > defaultMaxReconnectBackoff {
+					backoff = defaultMaxReconnectBackoff
+				}
+			}
+
+			log.WithField("backoff", backoff).Info("Reconnecting to beacon event stream")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+
+			if e.replay != nil {
+				fromSlot := atomic.LoadUint64(&e.lastSeenSlot)
+				replayed, rerr := e.replay(ctx, fromSlot)
+				if rerr != nil {
+					log.WithError(rerr).WithField("fromSlot", fromSlot).Warn("Failed to replay missed beacon events after reconnect")
+				}
+				for _, replayedEvent := range replayed {
+					if !e.publish(ctx, replayedEvent) {
+						return ctx.Err()
+					}
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+// consume opens the SSE connection and reads events from it until ctx is cancelled or the
+// connection drops, returning whether it ever successfully connected so Start can decide
+// whether to reset its backoff.
+func (e *EventStream) consume(ctx context.Context) (bool, error) {
+	url := fmt.Sprintf("%s/eth/v1/events?topics=%s", e.endpoint, eventStreamTopics)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, fmt.Errorf("build event stream request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("open event stream: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("open event stream: unexpected status %s", resp.Status)
+	}
+
+	var eventType string
+	var dataLines []string
+
+	flush := func() error {
+		if eventType == "" || len(dataLines) == 0 {
+			eventType = ""
+			dataLines = nil
+			return nil
+		}
+
+		currentType := eventType
+		event, err := decodeEvent(currentType, strings.Join(dataLines, "\n"))
+		eventType = ""
+		dataLines = nil
+		if err != nil {
+			return fmt.Errorf("decode %s event: %w", currentType, err)
+		}
+		if event == nil {
+			return nil
+		}
+
+		if !e.publish(ctx, event) {
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			if err := flush(); err != nil {
+				return true, err
+			}
+		case strings.HasPrefix(line, ":"):
+			// SSE comment, used by beacon nodes as a keep-alive; ignore it.
+		case strings.HasPrefix(line, "event:"):
+			eventType = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			dataLines = append(dataLines, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return true, fmt.Errorf("read event stream: %w", err)
+	}
+
+	return true, fmt.Errorf("event stream closed by beacon node")
+}
+
+// decodeEvent parses an SSE event's data payload according to its event type. An unrecognised
+// event type is not an error: the beacon API may add topics this relay doesn't subscribe to, or
+// the node may echo back a topic verbatim; decodeEvent returns a nil Event for it instead.
+func decodeEvent(eventType string, data string) (*Event, error) {
+	switch EventType(eventType) {
+	case EventTypeHead:
+		var payload HeadEvent
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, err
+		}
+		return &Event{Type: EventTypeHead, Head: &payload}, nil
+	case EventTypeFinalizedCheckpoint:
+		var payload FinalizedCheckpointEvent
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, err
+		}
+		return &Event{Type: EventTypeFinalizedCheckpoint, FinalizedCheckpoint: &payload}, nil
+	case EventTypeBlockGossip:
+		var payload BlockGossipEvent
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, err
+		}
+		return &Event{Type: EventTypeBlockGossip, BlockGossip: &payload}, nil
+	case EventTypeAttesterSlashing:
+		var payload AttesterSlashingEvent
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, err
+		}
+		return &Event{Type: EventTypeAttesterSlashing, AttesterSlashing: &payload}, nil
+	case EventTypeProposerSlashing:
+		var payload ProposerSlashingEvent
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, err
+		}
+		return &Event{Type: EventTypeProposerSlashing, ProposerSlashing: &payload}, nil
+	case EventTypeBLSToExecutionChange:
+		var payload BLSToExecutionChangeEvent
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, err
+		}
+		return &Event{Type: EventTypeBLSToExecutionChange, BLSToExecutionChange: &payload}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// publish advances lastSeenSlot (if event carries one) and sends event on the output channel,
+// returning false without sending if ctx is cancelled first.
+func (e *EventStream) publish(ctx context.Context, event *Event) bool {
+	if slot, ok := event.slot(); ok {
+		atomic.StoreUint64(&e.lastSeenSlot, slot)
+	}
+
+	select {
+	case e.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
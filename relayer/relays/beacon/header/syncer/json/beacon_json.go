@@ -1,7 +1,8 @@
 package json
 
 import (
-	"strings"
+	"encoding/json"
+	"fmt"
 )
 
 type InitialSync struct {
@@ -13,21 +14,21 @@ type InitialSync struct {
 }
 
 type BeaconHeader struct {
-	Slot          uint64 `json:"slot"`
-	ProposerIndex uint64 `json:"proposer_index"`
-	ParentRoot    string `json:"parent_root"`
-	StateRoot     string `json:"state_root"`
-	BodyRoot      string `json:"body_root"`
+	Slot          uint64  `json:"slot"`
+	ProposerIndex uint64  `json:"proposer_index"`
+	ParentRoot    Bytes32 `json:"parent_root"`
+	StateRoot     Bytes32 `json:"state_root"`
+	BodyRoot      Bytes32 `json:"body_root"`
 }
 
 type SyncCommittee struct {
-	Pubkeys         []string `json:"pubkeys"`
-	AggregatePubkey string   `json:"aggregate_pubkey"`
+	Pubkeys         []BLSPubKey `json:"pubkeys"`
+	AggregatePubkey BLSPubKey   `json:"aggregate_pubkey"`
 }
 
 type SyncAggregate struct {
-	SyncCommitteeBits      string `json:"sync_committee_bits"`
-	SyncCommitteeSignature string `json:"sync_committee_signature"`
+	SyncCommitteeBits      Hex            `json:"sync_committee_bits"`
+	SyncCommitteeSignature SignatureBytes `json:"sync_committee_signature"`
 }
 
 type SyncCommitteeUpdate struct {
@@ -83,8 +84,8 @@ type Checkpoint struct {
 }
 
 type SignedHeader struct {
-	Message   BeaconHeader `json:"message"`
-	Signature string       `json:"signature"`
+	Message   BeaconHeader   `json:"message"`
+	Signature SignatureBytes `json:"signature"`
 }
 
 type Block struct {
@@ -96,20 +97,20 @@ type Block struct {
 }
 
 type ExecutionPayload struct {
-	ParentHash      string `json:"parent_hash"`
-	FeeRecipient    string `json:"fee_recipient"`
-	StateRoot       string `json:"state_root"`
-	ReceiptsRoot    string `json:"receipts_root"`
-	LogsBloom       string `json:"logs_bloom"`
-	PrevRandao      string `json:"prev_randao"`
-	BlockNumber     uint64 `json:"block_number"`
-	GasLimit        uint64 `json:"gas_limit"`
-	GasUsed         uint64 `json:"gas_used"`
-	Timestamp       uint64 `json:"timestamp"`
-	ExtraData       string `json:"extra_data"`
-	BaseFeePerGas   uint64 `json:"base_fee_per_gas"`
-	BlockHash       string `json:"block_hash"`
-	TransactionRoot string `json:"transactions_root"`
+	ParentHash      Bytes32   `json:"parent_hash"`
+	FeeRecipient    Address   `json:"fee_recipient"`
+	StateRoot       Bytes32   `json:"state_root"`
+	ReceiptsRoot    Bytes32   `json:"receipts_root"`
+	LogsBloom       LogsBloom `json:"logs_bloom"`
+	PrevRandao      Bytes32   `json:"prev_randao"`
+	BlockNumber     uint64    `json:"block_number"`
+	GasLimit        uint64    `json:"gas_limit"`
+	GasUsed         uint64    `json:"gas_used"`
+	Timestamp       uint64    `json:"timestamp"`
+	ExtraData       Hex       `json:"extra_data"`
+	BaseFeePerGas   uint64    `json:"base_fee_per_gas"`
+	BlockHash       Bytes32   `json:"block_hash"`
+	TransactionRoot Bytes32   `json:"transactions_root"`
 }
 
 type Eth1Data struct {
@@ -131,27 +132,33 @@ type BlockBody struct {
 	ExecutionPayload  ExecutionPayload   `json:"execution_payload"`
 }
 
+// HeaderUpdate proves a beacon header and its execution payload against a previously synced
+// sync committee. Version names which fork ExecutionHeader was fetched under - "bellatrix",
+// "capella", "deneb" or "electra" - the same tags VersionedBlock's "version" envelope field uses;
+// ExecutionHeader is always shaped as ExecutionPayloadDeneb, the broadest of the three, with the
+// fields a given fork doesn't carry left at their zero value.
 type HeaderUpdate struct {
-	BeaconHeader              BeaconHeader     `json:"beacon_header"`
-	ExecutionHeader           ExecutionPayload `json:"execution_header"`
-	ExecutionBranch           []string         `json:"execution_branch"`
-	SyncAggregate             SyncAggregate    `json:"sync_aggregate"`
-	SignatureSlot             uint64           `json:"signature_slot"`
-	BlockRootBranch           []string         `json:"block_root_branch"`
-	BlockRootBranchHeaderRoot string           `json:"block_root_branch_header_root"`
+	Version                   string                `json:"version"`
+	BeaconHeader              BeaconHeader          `json:"beacon_header"`
+	ExecutionHeader           ExecutionPayloadDeneb `json:"execution_header"`
+	ExecutionBranch           []string              `json:"execution_branch"`
+	SyncAggregate             SyncAggregate         `json:"sync_aggregate"`
+	SignatureSlot             uint64                `json:"signature_slot"`
+	BlockRootBranch           []string              `json:"block_root_branch"`
+	BlockRootBranchHeaderRoot string                `json:"block_root_branch_header_root"`
 }
 
 type Attestation struct {
-	AggregationBits string          `json:"aggregation_bits"`
+	AggregationBits Hex             `json:"aggregation_bits"`
 	Data            AttestationData `json:"data"`
-	Signature       string          `json:"signature"`
+	Signature       SignatureBytes  `json:"signature"`
 }
 
 type DepositData struct {
-	Pubkey                string `json:"pubkey"`
-	WithdrawalCredentials string `json:"withdrawal_credentials"`
-	Amount                uint64 `json:"amount"`
-	Signature             string `json:"signature"`
+	Pubkey                BLSPubKey      `json:"pubkey"`
+	WithdrawalCredentials Bytes32        `json:"withdrawal_credentials"`
+	Amount                uint64         `json:"amount"`
+	Signature             SignatureBytes `json:"signature"`
 }
 
 type VoluntaryExit struct {
@@ -164,156 +171,157 @@ type Deposit struct {
 	Data  DepositData `json:"data"`
 }
 
-func (b *BeaconHeader) RemoveLeadingZeroHashes() {
-	b.ParentRoot = removeLeadingZeroHash(b.ParentRoot)
-	b.StateRoot = removeLeadingZeroHash(b.StateRoot)
-	b.BodyRoot = removeLeadingZeroHash(b.BodyRoot)
-}
-
-func (s *SyncCommittee) RemoveLeadingZeroHashes() {
-	for i, pubkey := range s.Pubkeys {
-		s.Pubkeys[i] = removeLeadingZeroHash(pubkey)
-	}
-
-	s.AggregatePubkey = removeLeadingZeroHash(s.AggregatePubkey)
-}
-
-func (p *ProposerSlashing) RemoveLeadingZeroHashes() {
-	p.SignedHeader1.RemoveLeadingZeroHashes()
-	p.SignedHeader2.RemoveLeadingZeroHashes()
-}
-
-func (a *AttesterSlashing) RemoveLeadingZeroHashes() {
-	a.Attestation1.RemoveLeadingZeroHashes()
-	a.Attestation2.RemoveLeadingZeroHashes()
-}
-
-func (i *IndexedAttestation) RemoveLeadingZeroHashes() {
-	i.Data.RemoveLeadingZeroHashes()
-	i.Signature = removeLeadingZeroHash(i.Signature)
-}
-
-func (a *AttestationData) RemoveLeadingZeroHashes() {
-	a.BeaconBlockRoot = removeLeadingZeroHash(a.BeaconBlockRoot)
-	a.Source.RemoveLeadingZeroHashes()
-	a.Target.RemoveLeadingZeroHashes()
+type Withdrawal struct {
+	Index          uint64 `json:"index"`
+	ValidatorIndex uint64 `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         uint64 `json:"amount"`
 }
 
-func (s *SignedHeader) RemoveLeadingZeroHashes() {
-	s.Message.RemoveLeadingZeroHashes()
-	s.Signature = removeLeadingZeroHash(s.Signature)
+type BLSToExecutionChange struct {
+	ValidatorIndex     uint64 `json:"validator_index"`
+	FromBLSPubkey      string `json:"from_bls_pubkey"`
+	ToExecutionAddress string `json:"to_execution_address"`
 }
 
-func (s *SyncAggregate) RemoveLeadingZeroHashes() {
-	s.SyncCommitteeBits = removeLeadingZeroHash(s.SyncCommitteeBits)
-	s.SyncCommitteeSignature = removeLeadingZeroHash(s.SyncCommitteeSignature)
+type SignedBLSToExecutionChange struct {
+	Message   BLSToExecutionChange `json:"message"`
+	Signature string               `json:"signature"`
 }
 
-func (a *Attestation) RemoveLeadingZeroHashes() {
-	a.AggregationBits = removeLeadingZeroHash(a.AggregationBits)
-	a.Data.RemoveLeadingZeroHashes()
-	a.Signature = removeLeadingZeroHash(a.Signature)
+// ExecutionPayloadCapella adds the withdrawals the Capella fork introduced to ExecutionPayload.
+type ExecutionPayloadCapella struct {
+	ExecutionPayload
+	Withdrawals []Withdrawal `json:"withdrawals"`
 }
 
-func (c *Checkpoint) RemoveLeadingZeroHashes() {
-	c.Root = removeLeadingZeroHash(c.Root)
+// ExecutionPayloadDeneb adds the blob-carrying fields the Deneb fork introduced to
+// ExecutionPayloadCapella.
+type ExecutionPayloadDeneb struct {
+	ExecutionPayloadCapella
+	BlobGasUsed     uint64 `json:"blob_gas_used"`
+	ExcessBlobGas   uint64 `json:"excess_blob_gas"`
+	WithdrawalsRoot string `json:"withdrawals_root"`
 }
 
-func (d *Deposit) RemoveLeadingZeroHashes() {
-	d.Data.Pubkey = removeLeadingZeroHash(d.Data.Pubkey)
-	d.Data.Signature = removeLeadingZeroHash(d.Data.Signature)
-	d.Data.WithdrawalCredentials = removeLeadingZeroHash(d.Data.WithdrawalCredentials)
+// blockBodyCommon holds the block body fields shared by every post-Bellatrix fork; it's
+// embedded by each fork-tagged BlockBody variant below rather than repeated in each one.
+type blockBodyCommon struct {
+	RandaoReveal      string             `json:"randao_reveal"`
+	Eth1Data          Eth1Data           `json:"eth1_data"`
+	Graffiti          string             `json:"graffiti"`
+	ProposerSlashings []ProposerSlashing `json:"proposer_slashings"`
+	AttesterSlashings []AttesterSlashing `json:"attester_slashings"`
+	Attestations      []Attestation      `json:"attestations"`
+	Deposits          []Deposit          `json:"deposits"`
+	VoluntaryExits    []VoluntaryExit    `json:"voluntary_exits"`
+	SyncAggregate     SyncAggregate      `json:"sync_aggregate"`
 }
 
-func (b *Block) RemoveLeadingZeroHashes() {
-	b.ParentRoot = removeLeadingZeroHash(b.ParentRoot)
-	b.StateRoot = removeLeadingZeroHash(b.StateRoot)
-	b.Body.RandaoReveal = removeLeadingZeroHash(b.Body.RandaoReveal)
-	b.Body.Eth1Data.DepositRoot = removeLeadingZeroHash(b.Body.Eth1Data.DepositRoot)
-	b.Body.Eth1Data.BlockHash = removeLeadingZeroHash(b.Body.Eth1Data.BlockHash)
-	b.Body.Graffiti = removeLeadingZeroHash(b.Body.Graffiti)
-
-	for i := range b.Body.ProposerSlashings {
-		b.Body.ProposerSlashings[i].RemoveLeadingZeroHashes()
+// BlockBodyBellatrix is BlockBody under its fork-tagged name, kept as a separate type so
+// VersionedBlock can hold it alongside BlockBodyCapella/BlockBodyDeneb.
+type BlockBodyBellatrix = BlockBody
+
+// BlockBodyCapella adds the withdrawals and BLS-to-execution-address changes the Capella fork
+// introduced.
+type BlockBodyCapella struct {
+	blockBodyCommon
+	ExecutionPayload      ExecutionPayloadCapella      `json:"execution_payload"`
+	BLSToExecutionChanges []SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+}
+
+// BlockBodyDeneb adds the KZG blob commitments the Deneb fork introduced.
+type BlockBodyDeneb struct {
+	blockBodyCommon
+	ExecutionPayload      ExecutionPayloadDeneb        `json:"execution_payload"`
+	BLSToExecutionChanges []SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	BlobKZGCommitments    []string                     `json:"blob_kzg_commitments"`
+}
+
+// BlockBodyElectra adds the execution-layer request queues (deposits, withdrawals,
+// consolidations) the Electra fork introduced; its execution payload and blob commitments are
+// otherwise unchanged from Deneb.
+type BlockBodyElectra struct {
+	blockBodyCommon
+	ExecutionPayload      ExecutionPayloadDeneb        `json:"execution_payload"`
+	BLSToExecutionChanges []SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	BlobKZGCommitments    []string                     `json:"blob_kzg_commitments"`
+	ExecutionRequests     *ExecutionRequests           `json:"execution_requests"`
+}
+
+// BlockCapella is Block with a Capella-shaped body.
+type BlockCapella struct {
+	Slot          uint64           `json:"slot"`
+	ProposerIndex uint64           `json:"proposer_index"`
+	ParentRoot    string           `json:"parent_root"`
+	StateRoot     string           `json:"state_root"`
+	Body          BlockBodyCapella `json:"body"`
+}
+
+// BlockDeneb is Block with a Deneb-shaped body.
+type BlockDeneb struct {
+	Slot          uint64         `json:"slot"`
+	ProposerIndex uint64         `json:"proposer_index"`
+	ParentRoot    string         `json:"parent_root"`
+	StateRoot     string         `json:"state_root"`
+	Body          BlockBodyDeneb `json:"body"`
+}
+
+// BlockElectra is Block with an Electra-shaped body.
+type BlockElectra struct {
+	Slot          uint64           `json:"slot"`
+	ProposerIndex uint64           `json:"proposer_index"`
+	ParentRoot    string           `json:"parent_root"`
+	StateRoot     string           `json:"state_root"`
+	Body          BlockBodyElectra `json:"body"`
+}
+
+// VersionedBlock wraps the envelope returned by GET /eth/v2/beacon/blocks/{id}, which tags its
+// response with a "version" field determining which of
+// Phase0/Altair/Bellatrix/Capella/Deneb/Electra actually holds a value. Block already models
+// the pre-Capella (Phase0/Altair/Bellatrix) shape, so only Capella, Deneb and Electra need their
+// own fork-tagged type.
+type VersionedBlock struct {
+	Version   string
+	Phase0    *Block
+	Altair    *Block
+	Bellatrix *Block
+	Capella   *BlockCapella
+	Deneb     *BlockDeneb
+	Electra   *BlockElectra
+}
+
+func (v *VersionedBlock) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Version string          `json:"version"`
+		Data    json.RawMessage `json:"data"`
 	}
-
-	for i := range b.Body.AttesterSlashings {
-		b.Body.AttesterSlashings[i].RemoveLeadingZeroHashes()
-	}
-
-	for i := range b.Body.Attestations {
-		b.Body.Attestations[i].RemoveLeadingZeroHashes()
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
 	}
 
-	for i := range b.Body.Deposits {
-		b.Body.Deposits[i].RemoveLeadingZeroHashes()
-	}
-
-	b.Body.SyncAggregate.RemoveLeadingZeroHashes()
-	b.Body.ExecutionPayload.RemoveLeadingZeroHashes()
-}
-
-func (e *ExecutionPayload) RemoveLeadingZeroHashes() {
-	e.ParentHash = removeLeadingZeroHash(e.ParentHash)
-	e.FeeRecipient = removeLeadingZeroHash(e.FeeRecipient)
-	e.StateRoot = removeLeadingZeroHash(e.StateRoot)
-	e.ReceiptsRoot = removeLeadingZeroHash(e.ReceiptsRoot)
-	e.LogsBloom = removeLeadingZeroHash(e.LogsBloom)
-	e.PrevRandao = removeLeadingZeroHash(e.PrevRandao)
-	e.ExtraData = removeLeadingZeroHash(e.ExtraData)
-	e.BlockHash = removeLeadingZeroHash(e.BlockHash)
-	e.TransactionRoot = removeLeadingZeroHash(e.TransactionRoot)
-}
-
-func (i *InitialSync) RemoveLeadingZeroHashes() {
-	i.Header.RemoveLeadingZeroHashes()
-	i.CurrentSyncCommittee.RemoveLeadingZeroHashes()
-
-	for k, branch := range i.CurrentSyncCommitteeBranch {
-		i.CurrentSyncCommitteeBranch[k] = removeLeadingZeroHash(branch)
+	v.Version = envelope.Version
+
+	switch envelope.Version {
+	case "phase0":
+		v.Phase0 = &Block{}
+		return json.Unmarshal(envelope.Data, v.Phase0)
+	case "altair":
+		v.Altair = &Block{}
+		return json.Unmarshal(envelope.Data, v.Altair)
+	case "bellatrix":
+		v.Bellatrix = &Block{}
+		return json.Unmarshal(envelope.Data, v.Bellatrix)
+	case "capella":
+		v.Capella = &BlockCapella{}
+		return json.Unmarshal(envelope.Data, v.Capella)
+	case "deneb":
+		v.Deneb = &BlockDeneb{}
+		return json.Unmarshal(envelope.Data, v.Deneb)
+	case "electra":
+		v.Electra = &BlockElectra{}
+		return json.Unmarshal(envelope.Data, v.Electra)
+	default:
+		return fmt.Errorf("unknown beacon block version %q", envelope.Version)
 	}
-
-	i.ValidatorsRoot = removeLeadingZeroHash(i.ValidatorsRoot)
-}
-
-func (s *SyncCommitteeUpdate) RemoveLeadingZeroHashes() {
-	s.AttestedHeader.RemoveLeadingZeroHashes()
-	s.NextSyncCommittee.RemoveLeadingZeroHashes()
-	s.NextSyncCommitteeBranch = removeLeadingZeroHashForSlice(s.NextSyncCommitteeBranch)
-	s.FinalizedHeader.RemoveLeadingZeroHashes()
-	s.FinalityBranch = removeLeadingZeroHashForSlice(s.FinalityBranch)
-	s.SyncAggregate.RemoveLeadingZeroHashes()
-	s.BlockRootsRoot = removeLeadingZeroHash(s.BlockRootsRoot)
-	s.BlockRootBranch = removeLeadingZeroHashForSlice(s.BlockRootBranch)
-}
-
-func (f *FinalizedHeaderUpdate) RemoveLeadingZeroHashes() {
-	f.AttestedHeader.RemoveLeadingZeroHashes()
-	f.FinalizedHeader.RemoveLeadingZeroHashes()
-	f.FinalityBranch = removeLeadingZeroHashForSlice(f.FinalityBranch)
-	f.SyncAggregate.RemoveLeadingZeroHashes()
-	f.BlockRootsRoot = removeLeadingZeroHash(f.BlockRootsRoot)
-	f.BlockRootBranch = removeLeadingZeroHashForSlice(f.BlockRootBranch)
-}
-
-func (h *HeaderUpdate) RemoveLeadingZeroHashes() {
-	h.BeaconHeader.RemoveLeadingZeroHashes()
-	h.ExecutionHeader.RemoveLeadingZeroHashes()
-	h.ExecutionBranch = removeLeadingZeroHashForSlice(h.ExecutionBranch)
-	h.SyncAggregate.RemoveLeadingZeroHashes()
-	h.BlockRootBranch = removeLeadingZeroHashForSlice(h.BlockRootBranch)
-	h.BlockRootBranchHeaderRoot = removeLeadingZeroHash(h.BlockRootBranchHeaderRoot)
-}
-
-func removeLeadingZeroHashForSlice(s []string) []string {
-	result := make([]string, len(s))
-
-	for i, item := range s {
-		result[i] = removeLeadingZeroHash(item)
-	}
-	return result
-}
-
-func removeLeadingZeroHash(s string) string {
-	return strings.Replace(s, "0x", "", 1)
 }
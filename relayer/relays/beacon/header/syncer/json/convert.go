@@ -0,0 +1,846 @@
+package json
+
+import (
+	"fmt"
+
+	"github.com/snowfork/snowbridge/relayer/relays/beacon/header/syncer/consensus"
+)
+
+// ToConsensus converts each wire struct above into its consensus-package counterpart, hex-decoding
+// and validating every field that is still a raw string here (chunk3-2 already migrated some
+// fields to typed bytes, so those are copied across unchanged). It is the single place this repo
+// parses beacon-API hex, replacing the old removeLeadingZeroHash pass: the consensus, SCALE and
+// SSZ layers never see an unvalidated string.
+
+// errAt prefixes err with a field name, composing a dotted path as the error propagates up
+// through nested ToConsensus calls, e.g. "attestations[3].data.beacon_block_root: odd-length hex".
+func errAt(field string, err error) error {
+	if fe, ok := err.(*fieldError); ok {
+		return &fieldError{path: field + "." + fe.path, err: fe.err}
+	}
+	return &fieldError{path: field, err: err}
+}
+
+// fieldError is a decode error annotated with the dotted field path it occurred at.
+type fieldError struct {
+	path string
+	err  error
+}
+
+func (e *fieldError) Error() string { return e.path + ": " + e.err.Error() }
+
+func (e *fieldError) Unwrap() error { return e.err }
+
+func (b BeaconHeader) ToConsensus() *consensus.BeaconHeader {
+	return &consensus.BeaconHeader{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		BodyRoot:      b.BodyRoot,
+	}
+}
+
+func (s SyncCommittee) ToConsensus() *consensus.SyncCommittee {
+	return &consensus.SyncCommittee{
+		Pubkeys:         s.Pubkeys,
+		AggregatePubkey: s.AggregatePubkey,
+	}
+}
+
+func (s SyncAggregate) ToConsensus() *consensus.SyncAggregate {
+	return &consensus.SyncAggregate{
+		SyncCommitteeBits:      s.SyncCommitteeBits,
+		SyncCommitteeSignature: s.SyncCommitteeSignature,
+	}
+}
+
+func (s SignedHeader) ToConsensus() *consensus.SignedHeader {
+	return &consensus.SignedHeader{
+		Message:   *s.Message.ToConsensus(),
+		Signature: s.Signature,
+	}
+}
+
+func (c Checkpoint) ToConsensus() (*consensus.Checkpoint, error) {
+	root, err := consensus.DecodeRoot(c.Root)
+	if err != nil {
+		return nil, errAt("root", err)
+	}
+
+	return &consensus.Checkpoint{Epoch: c.Epoch, Root: root}, nil
+}
+
+func (a AttestationData) ToConsensus() (*consensus.AttestationData, error) {
+	beaconBlockRoot, err := consensus.DecodeRoot(a.BeaconBlockRoot)
+	if err != nil {
+		return nil, errAt("beacon_block_root", err)
+	}
+
+	source, err := a.Source.ToConsensus()
+	if err != nil {
+		return nil, errAt("source", err)
+	}
+
+	target, err := a.Target.ToConsensus()
+	if err != nil {
+		return nil, errAt("target", err)
+	}
+
+	return &consensus.AttestationData{
+		Slot:            a.Slot,
+		Index:           a.Index,
+		BeaconBlockRoot: beaconBlockRoot,
+		Source:          *source,
+		Target:          *target,
+	}, nil
+}
+
+func (a Attestation) ToConsensus() (*consensus.Attestation, error) {
+	data, err := a.Data.ToConsensus()
+	if err != nil {
+		return nil, errAt("data", err)
+	}
+
+	return &consensus.Attestation{
+		AggregationBits: a.AggregationBits,
+		Data:            *data,
+		Signature:       a.Signature,
+	}, nil
+}
+
+func (i IndexedAttestation) ToConsensus() (*consensus.IndexedAttestation, error) {
+	data, err := i.Data.ToConsensus()
+	if err != nil {
+		return nil, errAt("data", err)
+	}
+
+	signature, err := consensus.DecodeSignature(i.Signature)
+	if err != nil {
+		return nil, errAt("signature", err)
+	}
+
+	return &consensus.IndexedAttestation{
+		AttestingIndices: i.AttestingIndices,
+		Data:             *data,
+		Signature:        signature,
+	}, nil
+}
+
+func (p ProposerSlashing) ToConsensus() *consensus.ProposerSlashing {
+	return &consensus.ProposerSlashing{
+		SignedHeader1: *p.SignedHeader1.ToConsensus(),
+		SignedHeader2: *p.SignedHeader2.ToConsensus(),
+	}
+}
+
+func (a AttesterSlashing) ToConsensus() (*consensus.AttesterSlashing, error) {
+	attestation1, err := a.Attestation1.ToConsensus()
+	if err != nil {
+		return nil, errAt("attestation_1", err)
+	}
+
+	attestation2, err := a.Attestation2.ToConsensus()
+	if err != nil {
+		return nil, errAt("attestation_2", err)
+	}
+
+	return &consensus.AttesterSlashing{Attestation1: *attestation1, Attestation2: *attestation2}, nil
+}
+
+func (e Eth1Data) ToConsensus() (*consensus.Eth1Data, error) {
+	depositRoot, err := consensus.DecodeRoot(e.DepositRoot)
+	if err != nil {
+		return nil, errAt("deposit_root", err)
+	}
+
+	blockHash, err := consensus.DecodeRoot(e.BlockHash)
+	if err != nil {
+		return nil, errAt("block_hash", err)
+	}
+
+	return &consensus.Eth1Data{DepositRoot: depositRoot, DepositCount: e.DepositCount, BlockHash: blockHash}, nil
+}
+
+func (d DepositData) ToConsensus() *consensus.DepositData {
+	return &consensus.DepositData{
+		Pubkey:                d.Pubkey,
+		WithdrawalCredentials: d.WithdrawalCredentials,
+		Amount:                d.Amount,
+		Signature:             d.Signature,
+	}
+}
+
+func (v VoluntaryExit) ToConsensus() *consensus.VoluntaryExit {
+	return &consensus.VoluntaryExit{Epoch: v.Epoch, ValidatorIndex: v.ValidatorIndex}
+}
+
+func (d Deposit) ToConsensus() (*consensus.Deposit, error) {
+	proof, err := consensus.DecodeRoots(d.Proof)
+	if err != nil {
+		return nil, errAt("proof", err)
+	}
+
+	return &consensus.Deposit{Proof: proof, Data: *d.Data.ToConsensus()}, nil
+}
+
+func (e ExecutionPayload) ToConsensus() *consensus.ExecutionPayload {
+	return &consensus.ExecutionPayload{
+		Fork:            consensus.ForkBellatrix,
+		ParentHash:      e.ParentHash,
+		FeeRecipient:    e.FeeRecipient,
+		StateRoot:       e.StateRoot,
+		ReceiptsRoot:    e.ReceiptsRoot,
+		LogsBloom:       e.LogsBloom,
+		PrevRandao:      e.PrevRandao,
+		BlockNumber:     e.BlockNumber,
+		GasLimit:        e.GasLimit,
+		GasUsed:         e.GasUsed,
+		Timestamp:       e.Timestamp,
+		ExtraData:       e.ExtraData,
+		BaseFeePerGas:   e.BaseFeePerGas,
+		BlockHash:       e.BlockHash,
+		TransactionRoot: e.TransactionRoot,
+	}
+}
+
+func (w Withdrawal) ToConsensus() (*consensus.Withdrawal, error) {
+	address, err := consensus.DecodeAddress(w.Address)
+	if err != nil {
+		return nil, errAt("address", err)
+	}
+
+	return &consensus.Withdrawal{
+		Index:          w.Index,
+		ValidatorIndex: w.ValidatorIndex,
+		Address:        address,
+		Amount:         w.Amount,
+	}, nil
+}
+
+// ToConsensus converts e's embedded Bellatrix-shaped fields as ExecutionPayload.ToConsensus does,
+// then adds the withdrawals_root Capella introduced, computed from the wire Withdrawals list
+// since the beacon API doesn't hand back the root directly at this level. fork lets a caller
+// converting an Electra-forked payload (which reuses this same wire shape) tag the result
+// ForkElectra instead of ForkCapella.
+func (e ExecutionPayloadCapella) ToConsensus(fork consensus.Fork) (*consensus.ExecutionPayload, error) {
+	payload := e.ExecutionPayload.ToConsensus()
+	payload.Fork = fork
+
+	withdrawals := make([]consensus.Withdrawal, len(e.Withdrawals))
+	for i, w := range e.Withdrawals {
+		converted, err := w.ToConsensus()
+		if err != nil {
+			return nil, errAt(fmt.Sprintf("withdrawals[%d]", i), err)
+		}
+		withdrawals[i] = *converted
+	}
+	payload.WithdrawalsRoot = consensus.WithdrawalsRoot(withdrawals)
+
+	return payload, nil
+}
+
+// ToConsensus converts e's embedded Capella-shaped fields as ExecutionPayloadCapella.ToConsensus
+// does, then adds the blob_gas_used/excess_blob_gas fields Deneb introduced. It ignores e's own
+// WithdrawalsRoot string: recomputing it from the Withdrawals list (as ExecutionPayloadCapella
+// does) means both forks go through the same derivation instead of trusting a beacon node's
+// precomputed value. fork lets a caller converting an Electra-forked payload (which reuses this
+// same wire shape) tag the result ForkElectra instead of ForkDeneb.
+func (e ExecutionPayloadDeneb) ToConsensus(fork consensus.Fork) (*consensus.ExecutionPayload, error) {
+	payload, err := e.ExecutionPayloadCapella.ToConsensus(fork)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.BlobGasUsed = e.BlobGasUsed
+	payload.ExcessBlobGas = e.ExcessBlobGas
+
+	return payload, nil
+}
+
+// toConsensus converts the fields every post-Bellatrix BlockBody variant shares, leaving Fork and
+// the execution-payload/fork-specific roots for each caller to fill in itself.
+func (c blockBodyCommon) toConsensus() (*consensus.BlockBody, error) {
+	randaoReveal, err := consensus.DecodeSignature(c.RandaoReveal)
+	if err != nil {
+		return nil, errAt("randao_reveal", err)
+	}
+
+	eth1Data, err := c.Eth1Data.ToConsensus()
+	if err != nil {
+		return nil, errAt("eth1_data", err)
+	}
+
+	graffiti, err := consensus.DecodeRoot(c.Graffiti)
+	if err != nil {
+		return nil, errAt("graffiti", err)
+	}
+
+	proposerSlashings := make([]consensus.ProposerSlashing, len(c.ProposerSlashings))
+	for i, p := range c.ProposerSlashings {
+		proposerSlashings[i] = *p.ToConsensus()
+	}
+
+	attesterSlashings := make([]consensus.AttesterSlashing, len(c.AttesterSlashings))
+	for i, a := range c.AttesterSlashings {
+		converted, err := a.ToConsensus()
+		if err != nil {
+			return nil, errAt(fmt.Sprintf("attester_slashings[%d]", i), err)
+		}
+		attesterSlashings[i] = *converted
+	}
+
+	attestations := make([]consensus.Attestation, len(c.Attestations))
+	for i, a := range c.Attestations {
+		converted, err := a.ToConsensus()
+		if err != nil {
+			return nil, errAt(fmt.Sprintf("attestations[%d]", i), err)
+		}
+		attestations[i] = *converted
+	}
+
+	deposits := make([]consensus.Deposit, len(c.Deposits))
+	for i, d := range c.Deposits {
+		converted, err := d.ToConsensus()
+		if err != nil {
+			return nil, errAt(fmt.Sprintf("deposits[%d]", i), err)
+		}
+		deposits[i] = *converted
+	}
+
+	voluntaryExits := make([]consensus.VoluntaryExit, len(c.VoluntaryExits))
+	for i, v := range c.VoluntaryExits {
+		voluntaryExits[i] = *v.ToConsensus()
+	}
+
+	return &consensus.BlockBody{
+		RandaoReveal:      randaoReveal,
+		Eth1Data:          *eth1Data,
+		Graffiti:          graffiti,
+		ProposerSlashings: proposerSlashings,
+		AttesterSlashings: attesterSlashings,
+		Attestations:      attestations,
+		Deposits:          deposits,
+		VoluntaryExits:    voluntaryExits,
+		SyncAggregate:     *c.SyncAggregate.ToConsensus(),
+	}, nil
+}
+
+// ToConsensus converts b, tagging the result with fork so HashTreeRoot/Verify know which
+// fork-specific chunks to include. Callers pass consensus.ForkBellatrix, since BlockBody (unlike
+// blockBodyCommon's other embedders) only ever models a pre-Capella body.
+func (b BlockBody) ToConsensus(fork consensus.Fork) (*consensus.BlockBody, error) {
+	common := blockBodyCommon{
+		RandaoReveal:      b.RandaoReveal,
+		Eth1Data:          b.Eth1Data,
+		Graffiti:          b.Graffiti,
+		ProposerSlashings: b.ProposerSlashings,
+		AttesterSlashings: b.AttesterSlashings,
+		Attestations:      b.Attestations,
+		Deposits:          b.Deposits,
+		VoluntaryExits:    b.VoluntaryExits,
+		SyncAggregate:     b.SyncAggregate,
+	}
+
+	body, err := common.toConsensus()
+	if err != nil {
+		return nil, err
+	}
+
+	body.Fork = fork
+	body.ExecutionPayload = *b.ExecutionPayload.ToConsensus()
+	body.ExecutionPayload.Fork = fork
+
+	return body, nil
+}
+
+// ToConsensus converts b's shared fields via blockBodyCommon.toConsensus, then adds the
+// withdrawals_root and bls_to_execution_changes_root Capella introduced.
+func (b BlockBodyCapella) ToConsensus() (*consensus.BlockBody, error) {
+	body, err := b.blockBodyCommon.toConsensus()
+	if err != nil {
+		return nil, err
+	}
+
+	executionPayload, err := b.ExecutionPayload.ToConsensus(consensus.ForkCapella)
+	if err != nil {
+		return nil, errAt("execution_payload", err)
+	}
+
+	blsToExecutionChanges, err := convertBLSToExecutionChanges(b.BLSToExecutionChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	body.Fork = consensus.ForkCapella
+	body.ExecutionPayload = *executionPayload
+	body.BLSToExecutionChangesRoot = consensus.BLSToExecutionChangesRoot(blsToExecutionChanges)
+
+	return body, nil
+}
+
+// ToConsensus converts b's shared fields via blockBodyCommon.toConsensus, then adds the roots
+// Capella introduced plus the blob_kzg_commitments_root Deneb introduced.
+func (b BlockBodyDeneb) ToConsensus() (*consensus.BlockBody, error) {
+	body, err := b.blockBodyCommon.toConsensus()
+	if err != nil {
+		return nil, err
+	}
+
+	executionPayload, err := b.ExecutionPayload.ToConsensus(consensus.ForkDeneb)
+	if err != nil {
+		return nil, errAt("execution_payload", err)
+	}
+
+	blsToExecutionChanges, err := convertBLSToExecutionChanges(b.BLSToExecutionChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	blobKZGCommitments, err := convertKZGCommitments(b.BlobKZGCommitments)
+	if err != nil {
+		return nil, err
+	}
+
+	body.Fork = consensus.ForkDeneb
+	body.ExecutionPayload = *executionPayload
+	body.BLSToExecutionChangesRoot = consensus.BLSToExecutionChangesRoot(blsToExecutionChanges)
+	body.BlobKZGCommitmentsRoot = consensus.BlobKZGCommitmentsRoot(blobKZGCommitments)
+
+	return body, nil
+}
+
+// ToConsensus converts b's shared fields via blockBodyCommon.toConsensus, then adds the roots
+// Capella and Deneb introduced plus the execution_requests_root Electra introduced. A nil
+// ExecutionRequests (the API omitting the field) is treated as three empty request queues rather
+// than leaving ExecutionRequestsRoot at the zero Bytes32 - the real SSZ root of three empty lists
+// is not all-zero, so skipping the computation would produce a body root the chain never signed.
+func (b BlockBodyElectra) ToConsensus() (*consensus.BlockBody, error) {
+	body, err := b.blockBodyCommon.toConsensus()
+	if err != nil {
+		return nil, err
+	}
+
+	executionPayload, err := b.ExecutionPayload.ToConsensus(consensus.ForkElectra)
+	if err != nil {
+		return nil, errAt("execution_payload", err)
+	}
+
+	blsToExecutionChanges, err := convertBLSToExecutionChanges(b.BLSToExecutionChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	blobKZGCommitments, err := convertKZGCommitments(b.BlobKZGCommitments)
+	if err != nil {
+		return nil, err
+	}
+
+	body.Fork = consensus.ForkElectra
+	body.ExecutionPayload = *executionPayload
+	body.BLSToExecutionChangesRoot = consensus.BLSToExecutionChangesRoot(blsToExecutionChanges)
+	body.BlobKZGCommitmentsRoot = consensus.BlobKZGCommitmentsRoot(blobKZGCommitments)
+	executionRequests := b.ExecutionRequests
+	if executionRequests == nil {
+		executionRequests = &ExecutionRequests{}
+	}
+	body.ExecutionRequestsRoot = executionRequests.ToConsensus().HashTreeRoot()
+
+	return body, nil
+}
+
+// convertBLSToExecutionChanges converts the BLSToExecutionChanges list shared by
+// BlockBodyCapella/Deneb/Electra.
+func convertBLSToExecutionChanges(changes []SignedBLSToExecutionChange) ([]consensus.SignedBLSToExecutionChange, error) {
+	converted := make([]consensus.SignedBLSToExecutionChange, len(changes))
+	for i, c := range changes {
+		change, err := c.ToConsensus()
+		if err != nil {
+			return nil, errAt(fmt.Sprintf("bls_to_execution_changes[%d]", i), err)
+		}
+		converted[i] = *change
+	}
+	return converted, nil
+}
+
+// convertKZGCommitments converts the BlobKZGCommitments hex list shared by BlockBodyDeneb/Electra.
+func convertKZGCommitments(commitments []string) ([]consensus.KZGCommitment, error) {
+	converted := make([]consensus.KZGCommitment, len(commitments))
+	for i, s := range commitments {
+		commitment, err := consensus.DecodeKZGCommitment(s)
+		if err != nil {
+			return nil, errAt(fmt.Sprintf("blob_kzg_commitments[%d]", i), err)
+		}
+		converted[i] = commitment
+	}
+	return converted, nil
+}
+
+func (b BLSToExecutionChange) ToConsensus() (*consensus.BLSToExecutionChange, error) {
+	fromBLSPubkey, err := consensus.DecodePubKey(b.FromBLSPubkey)
+	if err != nil {
+		return nil, errAt("from_bls_pubkey", err)
+	}
+
+	toExecutionAddress, err := consensus.DecodeAddress(b.ToExecutionAddress)
+	if err != nil {
+		return nil, errAt("to_execution_address", err)
+	}
+
+	return &consensus.BLSToExecutionChange{
+		ValidatorIndex:     b.ValidatorIndex,
+		FromBLSPubkey:      fromBLSPubkey,
+		ToExecutionAddress: toExecutionAddress,
+	}, nil
+}
+
+func (s SignedBLSToExecutionChange) ToConsensus() (*consensus.SignedBLSToExecutionChange, error) {
+	message, err := s.Message.ToConsensus()
+	if err != nil {
+		return nil, errAt("message", err)
+	}
+
+	signature, err := consensus.DecodeSignature(s.Signature)
+	if err != nil {
+		return nil, errAt("signature", err)
+	}
+
+	return &consensus.SignedBLSToExecutionChange{Message: *message, Signature: signature}, nil
+}
+
+func (d DepositRequest) ToConsensus() *consensus.DepositRequest {
+	return &consensus.DepositRequest{
+		Pubkey:                d.Pubkey,
+		WithdrawalCredentials: d.WithdrawalCredentials,
+		Amount:                d.Amount,
+		Signature:             d.Signature,
+		Index:                 d.Index,
+	}
+}
+
+func (w WithdrawalRequest) ToConsensus() *consensus.WithdrawalRequest {
+	return &consensus.WithdrawalRequest{
+		SourceAddress:   w.SourceAddress,
+		ValidatorPubkey: w.ValidatorPubkey,
+		Amount:          w.Amount,
+	}
+}
+
+func (c ConsolidationRequest) ToConsensus() *consensus.ConsolidationRequest {
+	return &consensus.ConsolidationRequest{
+		SourceAddress: c.SourceAddress,
+		SourcePubkey:  c.SourcePubkey,
+		TargetPubkey:  c.TargetPubkey,
+	}
+}
+
+// ToConsensus converts e's three request queues. It never fails: every field of DepositRequest/
+// WithdrawalRequest/ConsolidationRequest is already a typed consensus alias (see primitives.go),
+// so there's no hex left to validate at this boundary.
+func (e ExecutionRequests) ToConsensus() *consensus.ExecutionRequests {
+	deposits := make([]consensus.DepositRequest, len(e.Deposits))
+	for i, d := range e.Deposits {
+		deposits[i] = *d.ToConsensus()
+	}
+
+	withdrawals := make([]consensus.WithdrawalRequest, len(e.Withdrawals))
+	for i, w := range e.Withdrawals {
+		withdrawals[i] = *w.ToConsensus()
+	}
+
+	consolidations := make([]consensus.ConsolidationRequest, len(e.Consolidations))
+	for i, c := range e.Consolidations {
+		consolidations[i] = *c.ToConsensus()
+	}
+
+	return &consensus.ExecutionRequests{Deposits: deposits, Withdrawals: withdrawals, Consolidations: consolidations}
+}
+
+func (b Block) ToConsensus() (*consensus.Block, error) {
+	parentRoot, err := consensus.DecodeRoot(b.ParentRoot)
+	if err != nil {
+		return nil, errAt("parent_root", err)
+	}
+
+	stateRoot, err := consensus.DecodeRoot(b.StateRoot)
+	if err != nil {
+		return nil, errAt("state_root", err)
+	}
+
+	body, err := b.Body.ToConsensus(consensus.ForkBellatrix)
+	if err != nil {
+		return nil, errAt("body", err)
+	}
+
+	return &consensus.Block{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		Body:          *body,
+	}, nil
+}
+
+// ToConsensus converts b, mirroring Block.ToConsensus but with a Capella-shaped body.
+func (b BlockCapella) ToConsensus() (*consensus.Block, error) {
+	parentRoot, err := consensus.DecodeRoot(b.ParentRoot)
+	if err != nil {
+		return nil, errAt("parent_root", err)
+	}
+
+	stateRoot, err := consensus.DecodeRoot(b.StateRoot)
+	if err != nil {
+		return nil, errAt("state_root", err)
+	}
+
+	body, err := b.Body.ToConsensus()
+	if err != nil {
+		return nil, errAt("body", err)
+	}
+
+	return &consensus.Block{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		Body:          *body,
+	}, nil
+}
+
+// ToConsensus converts b, mirroring Block.ToConsensus but with a Deneb-shaped body.
+func (b BlockDeneb) ToConsensus() (*consensus.Block, error) {
+	parentRoot, err := consensus.DecodeRoot(b.ParentRoot)
+	if err != nil {
+		return nil, errAt("parent_root", err)
+	}
+
+	stateRoot, err := consensus.DecodeRoot(b.StateRoot)
+	if err != nil {
+		return nil, errAt("state_root", err)
+	}
+
+	body, err := b.Body.ToConsensus()
+	if err != nil {
+		return nil, errAt("body", err)
+	}
+
+	return &consensus.Block{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		Body:          *body,
+	}, nil
+}
+
+// ToConsensus converts b, mirroring Block.ToConsensus but with an Electra-shaped body.
+func (b BlockElectra) ToConsensus() (*consensus.Block, error) {
+	parentRoot, err := consensus.DecodeRoot(b.ParentRoot)
+	if err != nil {
+		return nil, errAt("parent_root", err)
+	}
+
+	stateRoot, err := consensus.DecodeRoot(b.StateRoot)
+	if err != nil {
+		return nil, errAt("state_root", err)
+	}
+
+	body, err := b.Body.ToConsensus()
+	if err != nil {
+		return nil, errAt("body", err)
+	}
+
+	return &consensus.Block{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		Body:          *body,
+	}, nil
+}
+
+// ToConsensus dispatches on v.Version to the matching BlockX.ToConsensus, so a live Capella/Deneb/
+// Electra beacon block fetched via VersionedBlock has a route into SCALE/SSZ, not just the
+// pre-Capella shape Block models.
+func (v VersionedBlock) ToConsensus() (*consensus.Block, error) {
+	switch v.Version {
+	case "phase0":
+		return v.Phase0.ToConsensus()
+	case "altair":
+		return v.Altair.ToConsensus()
+	case "bellatrix":
+		return v.Bellatrix.ToConsensus()
+	case "capella":
+		return v.Capella.ToConsensus()
+	case "deneb":
+		return v.Deneb.ToConsensus()
+	case "electra":
+		return v.Electra.ToConsensus()
+	default:
+		return nil, fmt.Errorf("unknown beacon block version %q", v.Version)
+	}
+}
+
+func (i InitialSync) ToConsensus() (*consensus.InitialSync, error) {
+	currentSyncCommitteeBranch, err := consensus.DecodeRoots(i.CurrentSyncCommitteeBranch)
+	if err != nil {
+		return nil, errAt("current_sync_committee_branch", err)
+	}
+
+	validatorsRoot, err := consensus.DecodeRoot(i.ValidatorsRoot)
+	if err != nil {
+		return nil, errAt("validators_root", err)
+	}
+
+	return &consensus.InitialSync{
+		Header:                     *i.Header.ToConsensus(),
+		CurrentSyncCommittee:       *i.CurrentSyncCommittee.ToConsensus(),
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+		ValidatorsRoot:             validatorsRoot,
+		ImportTime:                 i.ImportTime,
+	}, nil
+}
+
+// ToConsensus converts s and verifies its Merkle branches before returning it, so a malformed or
+// invalid update from a malicious or buggy beacon endpoint is rejected here rather than wasting
+// gas on an on-chain submission that would fail the same check.
+func (s SyncCommitteeUpdate) ToConsensus(genesisValidatorsRoot consensus.Bytes32) (*consensus.SyncCommitteeUpdate, error) {
+	nextSyncCommitteeBranch, err := consensus.DecodeRoots(s.NextSyncCommitteeBranch)
+	if err != nil {
+		return nil, errAt("next_sync_committee_branch", err)
+	}
+
+	finalityBranch, err := consensus.DecodeRoots(s.FinalityBranch)
+	if err != nil {
+		return nil, errAt("finality_branch", err)
+	}
+
+	blockRootsRoot, err := consensus.DecodeRoot(s.BlockRootsRoot)
+	if err != nil {
+		return nil, errAt("block_roots_root", err)
+	}
+
+	blockRootBranch, err := consensus.DecodeRoots(s.BlockRootBranch)
+	if err != nil {
+		return nil, errAt("block_roots_branch", err)
+	}
+
+	update := &consensus.SyncCommitteeUpdate{
+		AttestedHeader:          *s.AttestedHeader.ToConsensus(),
+		NextSyncCommittee:       *s.NextSyncCommittee.ToConsensus(),
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         *s.FinalizedHeader.ToConsensus(),
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           *s.SyncAggregate.ToConsensus(),
+		SyncCommitteePeriod:     s.SyncCommitteePeriod,
+		SignatureSlot:           s.SignatureSlot,
+		BlockRootsRoot:          blockRootsRoot,
+		BlockRootBranch:         blockRootBranch,
+	}
+
+	if !update.Verify(genesisValidatorsRoot) {
+		return nil, fmt.Errorf("sync committee update failed merkle branch verification")
+	}
+
+	return update, nil
+}
+
+// ToConsensus converts f and verifies its Merkle branches before returning it. See
+// SyncCommitteeUpdate.ToConsensus for why.
+func (f FinalizedHeaderUpdate) ToConsensus(genesisValidatorsRoot consensus.Bytes32) (*consensus.FinalizedHeaderUpdate, error) {
+	finalityBranch, err := consensus.DecodeRoots(f.FinalityBranch)
+	if err != nil {
+		return nil, errAt("finality_branch", err)
+	}
+
+	blockRootsRoot, err := consensus.DecodeRoot(f.BlockRootsRoot)
+	if err != nil {
+		return nil, errAt("block_roots_root", err)
+	}
+
+	blockRootBranch, err := consensus.DecodeRoots(f.BlockRootBranch)
+	if err != nil {
+		return nil, errAt("block_roots_branch", err)
+	}
+
+	update := &consensus.FinalizedHeaderUpdate{
+		AttestedHeader:  *f.AttestedHeader.ToConsensus(),
+		FinalizedHeader: *f.FinalizedHeader.ToConsensus(),
+		FinalityBranch:  finalityBranch,
+		SyncAggregate:   *f.SyncAggregate.ToConsensus(),
+		SignatureSlot:   f.SignatureSlot,
+		BlockRootsRoot:  blockRootsRoot,
+		BlockRootBranch: blockRootBranch,
+	}
+
+	if !update.Verify(genesisValidatorsRoot) {
+		return nil, fmt.Errorf("finalized header update failed merkle branch verification")
+	}
+
+	return update, nil
+}
+
+// headerUpdateFork maps the "version" tag HeaderUpdate is fetched with onto the consensus.Fork
+// its ExecutionHeader conversion (and thus HeaderUpdate.Verify's gindex choice) should use. An
+// unrecognised or empty version falls back to Bellatrix, matching the pre-fork-awareness default.
+func headerUpdateFork(version string) consensus.Fork {
+	switch version {
+	case "capella":
+		return consensus.ForkCapella
+	case "deneb":
+		return consensus.ForkDeneb
+	case "electra":
+		return consensus.ForkElectra
+	default:
+		return consensus.ForkBellatrix
+	}
+}
+
+// ToConsensus converts h, dispatching its ExecutionHeader conversion on h.Version so a
+// Capella/Deneb/Electra-fetched header gets its fork-specific fields (withdrawals_root,
+// blob_gas_used, excess_blob_gas) carried across instead of silently dropped, then verifies its
+// Merkle branches against the correct generalized index before returning it. See
+// SyncCommitteeUpdate.ToConsensus for why verification happens here.
+func (h HeaderUpdate) ToConsensus(genesisValidatorsRoot consensus.Bytes32) (*consensus.HeaderUpdate, error) {
+	executionBranch, err := consensus.DecodeRoots(h.ExecutionBranch)
+	if err != nil {
+		return nil, errAt("execution_branch", err)
+	}
+
+	blockRootBranch, err := consensus.DecodeRoots(h.BlockRootBranch)
+	if err != nil {
+		return nil, errAt("block_root_branch", err)
+	}
+
+	blockRootBranchHeaderRoot, err := consensus.DecodeRoot(h.BlockRootBranchHeaderRoot)
+	if err != nil {
+		return nil, errAt("block_root_branch_header_root", err)
+	}
+
+	fork := headerUpdateFork(h.Version)
+	var executionHeader *consensus.ExecutionPayload
+	if fork == consensus.ForkBellatrix {
+		executionHeader = h.ExecutionHeader.ExecutionPayload.ToConsensus()
+	} else {
+		executionHeader, err = h.ExecutionHeader.ToConsensus(fork)
+		if err != nil {
+			return nil, errAt("execution_header", err)
+		}
+	}
+
+	update := &consensus.HeaderUpdate{
+		BeaconHeader:              *h.BeaconHeader.ToConsensus(),
+		ExecutionHeader:           *executionHeader,
+		ExecutionBranch:           executionBranch,
+		SyncAggregate:             *h.SyncAggregate.ToConsensus(),
+		SignatureSlot:             h.SignatureSlot,
+		BlockRootBranch:           blockRootBranch,
+		BlockRootBranchHeaderRoot: blockRootBranchHeaderRoot,
+	}
+
+	if !update.Verify(genesisValidatorsRoot) {
+		return nil, fmt.Errorf("header update failed merkle branch verification")
+	}
+
+	return update, nil
+}
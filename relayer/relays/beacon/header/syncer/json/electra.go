@@ -0,0 +1,87 @@
+package json
+
+import "github.com/snowfork/go-substrate-rpc-client/v4/scale"
+
+// ExecutionRequests holds the three execution-layer request queues the Electra fork surfaces
+// through BeaconBlockBody: deposits (EIP-6110), withdrawals (EIP-7002) and consolidations
+// (EIP-7251). These originate on the execution side, but the beacon block carries them so the
+// parachain side can verify the deposit queue without trusting the execution client directly -
+// this is how Snowbridge deposits will be routed once staking is execution-driven.
+type ExecutionRequests struct {
+	Deposits       []DepositRequest       `json:"deposits"`
+	Withdrawals    []WithdrawalRequest    `json:"withdrawals"`
+	Consolidations []ConsolidationRequest `json:"consolidations"`
+}
+
+// DepositRequest is an EIP-6110 deposit surfaced through the execution payload. Index gives its
+// position in the deposit contract's request queue, distinct from the deposit index found in
+// Eth1Data/DepositData.
+type DepositRequest struct {
+	Pubkey                BLSPubKey      `json:"pubkey"`
+	WithdrawalCredentials Bytes32        `json:"withdrawal_credentials"`
+	Amount                uint64         `json:"amount"`
+	Signature             SignatureBytes `json:"signature"`
+	Index                 uint64         `json:"index"`
+}
+
+// WithdrawalRequest is an EIP-7002 execution-layer triggered withdrawal request.
+type WithdrawalRequest struct {
+	SourceAddress   Address   `json:"source_address"`
+	ValidatorPubkey BLSPubKey `json:"validator_pubkey"`
+	Amount          uint64    `json:"amount"`
+}
+
+// ConsolidationRequest is an EIP-7251 request to consolidate one validator into another.
+type ConsolidationRequest struct {
+	SourceAddress Address   `json:"source_address"`
+	SourcePubkey  BLSPubKey `json:"source_pubkey"`
+	TargetPubkey  BLSPubKey `json:"target_pubkey"`
+}
+
+func (d DepositRequest) Encode(encoder scale.Encoder) error {
+	if err := d.Pubkey.Encode(encoder); err != nil {
+		return err
+	}
+	if err := d.WithdrawalCredentials.Encode(encoder); err != nil {
+		return err
+	}
+	if err := encoder.Encode(d.Amount); err != nil {
+		return err
+	}
+	if err := d.Signature.Encode(encoder); err != nil {
+		return err
+	}
+	return encoder.Encode(d.Index)
+}
+
+func (w WithdrawalRequest) Encode(encoder scale.Encoder) error {
+	if err := w.SourceAddress.Encode(encoder); err != nil {
+		return err
+	}
+	if err := w.ValidatorPubkey.Encode(encoder); err != nil {
+		return err
+	}
+	return encoder.Encode(w.Amount)
+}
+
+func (c ConsolidationRequest) Encode(encoder scale.Encoder) error {
+	if err := c.SourceAddress.Encode(encoder); err != nil {
+		return err
+	}
+	if err := c.SourcePubkey.Encode(encoder); err != nil {
+		return err
+	}
+	return c.TargetPubkey.Encode(encoder)
+}
+
+// Encode SCALE-encodes each request queue as a compact-length-prefixed vector, relying on
+// scale.Encoder's generic slice handling to call each element's own Encode method in turn.
+func (e ExecutionRequests) Encode(encoder scale.Encoder) error {
+	if err := encoder.Encode(e.Deposits); err != nil {
+		return err
+	}
+	if err := encoder.Encode(e.Withdrawals); err != nil {
+		return err
+	}
+	return encoder.Encode(e.Consolidations)
+}
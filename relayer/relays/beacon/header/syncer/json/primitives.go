@@ -0,0 +1,16 @@
+package json
+
+import "github.com/snowfork/snowbridge/relayer/relays/beacon/header/syncer/consensus"
+
+// Bytes32, BLSPubKey, SignatureBytes, Address, LogsBloom and Hex are aliased from the consensus
+// package rather than redefined here, so the JSON structs below get hex MarshalJSON/UnmarshalJSON
+// and SCALE Encode/Decode for free, while ToConsensus (convert.go) can hand these same values to
+// the rest of consensus.X unchanged instead of re-decoding them.
+type (
+	Bytes32        = consensus.Bytes32
+	BLSPubKey      = consensus.BLSPubKey
+	SignatureBytes = consensus.SignatureBytes
+	Address        = consensus.Address
+	LogsBloom      = consensus.LogsBloom
+	Hex            = consensus.Hex
+)
@@ -0,0 +1,226 @@
+package parachain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/snowfork/snowbridge/relayer/chain/ethereum"
+	"github.com/snowfork/snowbridge/relayer/contracts/basic"
+)
+
+// defaultNonceTrackerConfirmations and defaultNonceReconciliationInterval are used whenever
+// NewEthNonceTracker is called with a zero confirmations/reconciliationBlocks argument.
+const (
+	defaultNonceTrackerConfirmations   = 6
+	defaultNonceReconciliationInterval = 256
+)
+
+// EthNonceTracker keeps an in-memory view of the latest nonce BasicInboundChannel has
+// delivered for each configured account, kept current by watching MessageDispatched logs
+// rather than polling basicContract.Nonce once per account on every scan. findTasks consults
+// it via Get instead of calling the contract directly.
+type EthNonceTracker struct {
+	ethConn              *ethereum.Connection
+	basicContract        *basic.BasicInboundChannel
+	accounts             [][32]byte
+	confirmations        uint64
+	reconciliationBlocks uint64
+
+	mu     sync.RWMutex
+	nonces map[[32]byte]uint64
+	blocks map[[32]byte]uint64
+}
+
+// NewEthNonceTracker constructs a tracker for the given accounts. confirmations is how many
+// Ethereum blocks to wait behind the chain tip before treating a MessageDispatched log as
+// final, guarding against reorgs; 0 falls back to defaultNonceTrackerConfirmations.
+// reconciliationBlocks is how often (in confirmed blocks) the tracker re-checks its cache
+// against Nonce() to repair any drift a missed or misparsed log may have caused; 0 falls back
+// to defaultNonceReconciliationInterval.
+func NewEthNonceTracker(
+	ethConn *ethereum.Connection,
+	basicContract *basic.BasicInboundChannel,
+	accounts [][32]byte,
+	confirmations uint64,
+	reconciliationBlocks uint64,
+) *EthNonceTracker {
+	if confirmations == 0 {
+		confirmations = defaultNonceTrackerConfirmations
+	}
+	if reconciliationBlocks == 0 {
+		reconciliationBlocks = defaultNonceReconciliationInterval
+	}
+	return &EthNonceTracker{
+		ethConn:              ethConn,
+		basicContract:        basicContract,
+		accounts:             accounts,
+		confirmations:        confirmations,
+		reconciliationBlocks: reconciliationBlocks,
+		nonces:               make(map[[32]byte]uint64, len(accounts)),
+		blocks:               make(map[[32]byte]uint64, len(accounts)),
+	}
+}
+
+// Get returns the latest nonce BasicInboundChannel has delivered for account, and the Ethereum
+// block number the tracker last observed it advance at (0 if only known from the startup
+// backfill).
+func (t *EthNonceTracker) Get(account [32]byte) (uint64, uint64) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.nonces[account], t.blocks[account]
+}
+
+// Start backfills every account's nonce from the contract's own view, then tracks new Ethereum
+// heads to scan for MessageDispatched logs and periodically reconcile the cache against
+// Nonce(), until ctx is cancelled.
+func (t *EthNonceTracker) Start(ctx context.Context, eg *errgroup.Group) error {
+	if err := t.backfill(ctx); err != nil {
+		return fmt.Errorf("backfill initial nonces: %w", err)
+	}
+
+	heads := make(chan *gethTypes.Header)
+	headSub, err := t.ethConn.Client().SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return fmt.Errorf("subscribe to new eth heads: %w", err)
+	}
+
+	eg.Go(func() error {
+		defer headSub.Unsubscribe()
+
+		var lastScannedBlock uint64
+		var blocksSinceReconcile uint64
+
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-headSub.Err():
+				return fmt.Errorf("eth head subscription failed: %w", err)
+			case head := <-heads:
+				headNumber := head.Number.Uint64()
+				if headNumber < t.confirmations {
+					continue
+				}
+				confirmedBlock := headNumber - t.confirmations
+				if confirmedBlock <= lastScannedBlock {
+					continue
+				}
+
+				startBlock := lastScannedBlock + 1
+				if lastScannedBlock == 0 {
+					// First head seen since backfill: only track forward from here rather
+					// than rescanning the chain's entire history of MessageDispatched logs.
+					startBlock = confirmedBlock
+				}
+
+				if err := t.scanRange(ctx, startBlock, confirmedBlock); err != nil {
+					return err
+				}
+
+				blocksSinceReconcile += confirmedBlock - lastScannedBlock
+				lastScannedBlock = confirmedBlock
+
+				if blocksSinceReconcile >= t.reconciliationBlocks {
+					t.reconcile(ctx)
+					blocksSinceReconcile = 0
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+// backfill seeds the cache with each account's current delivered nonce, so the tracker has a
+// correct starting point before any MessageDispatched logs have been observed.
+func (t *EthNonceTracker) backfill(ctx context.Context) error {
+	options := bind.CallOpts{Pending: true, Context: ctx}
+
+	for _, account := range t.accounts {
+		nonce, err := t.basicContract.Nonce(&options, account)
+		if err != nil {
+			return fmt.Errorf("fetch nonce for account '%v': %w", common.Bytes2Hex(account[:]), err)
+		}
+
+		t.mu.Lock()
+		t.nonces[account] = nonce
+		t.mu.Unlock()
+
+		log.WithFields(log.Fields{
+			"account": common.Bytes2Hex(account[:]),
+			"nonce":   nonce,
+		}).Info("Backfilled initial nonce for account from BasicInboundChannel")
+	}
+
+	return nil
+}
+
+// scanRange advances the cache from every MessageDispatched log emitted between from and to
+// (inclusive), for any account - not just the ones this tracker was constructed with - so a
+// later call to Get for an account added after construction still sees activity recorded
+// within this range.
+func (t *EthNonceTracker) scanRange(ctx context.Context, from, to uint64) error {
+	filterOpts := bind.FilterOpts{Start: from, End: &to, Context: ctx}
+
+	iter, err := t.basicContract.FilterMessageDispatched(&filterOpts, nil)
+	if err != nil {
+		return fmt.Errorf("filter MessageDispatched logs from %v to %v: %w", from, to, err)
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		event := iter.Event
+
+		t.mu.Lock()
+		if event.Nonce > t.nonces[event.Origin] {
+			t.nonces[event.Origin] = event.Nonce
+			t.blocks[event.Origin] = event.Raw.BlockNumber
+		}
+		t.mu.Unlock()
+
+		log.WithFields(log.Fields{
+			"account":     common.Bytes2Hex(event.Origin[:]),
+			"nonce":       event.Nonce,
+			"blockNumber": event.Raw.BlockNumber,
+		}).Debug("Observed MessageDispatched log, advancing cached nonce")
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("iterate MessageDispatched logs from %v to %v: %w", from, to, err)
+	}
+
+	return nil
+}
+
+// reconcile compares the cached nonce for every tracked account against the contract's own
+// Nonce() view, logging and repairing any drift a missed or misparsed log may have caused.
+func (t *EthNonceTracker) reconcile(ctx context.Context) {
+	options := bind.CallOpts{Pending: true, Context: ctx}
+
+	for _, account := range t.accounts {
+		nonce, err := t.basicContract.Nonce(&options, account)
+		if err != nil {
+			log.WithError(err).WithField("account", common.Bytes2Hex(account[:])).
+				Warn("Failed to reconcile cached nonce against contract")
+			continue
+		}
+
+		t.mu.Lock()
+		cached := t.nonces[account]
+		if nonce != cached {
+			log.WithFields(log.Fields{
+				"account":       common.Bytes2Hex(account[:]),
+				"cachedNonce":   cached,
+				"contractNonce": nonce,
+			}).Warn("Cached nonce drifted from BasicInboundChannel, repairing from Nonce()")
+			t.nonces[account] = nonce
+		}
+		t.mu.Unlock()
+	}
+}
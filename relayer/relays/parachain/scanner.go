@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -17,6 +18,14 @@ import (
 	"github.com/snowfork/snowbridge/relayer/chain/parachain"
 	"github.com/snowfork/snowbridge/relayer/chain/relaychain"
 	"github.com/snowfork/snowbridge/relayer/contracts/basic"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultScanWorkerPoolSize and defaultParallelScanThreshold are used whenever SourceConfig
+// leaves ScanWorkerPoolSize/ParallelScanThreshold unset (zero value).
+const (
+	defaultScanWorkerPoolSize    = 8
+	defaultParallelScanThreshold = 100
 )
 
 type Scanner struct {
@@ -28,6 +37,35 @@ type Scanner struct {
 	tasks            chan<- *Task
 	eventQueryClient QueryClient
 	accounts         [][32]byte
+	nonceTracker     *EthNonceTracker
+	// scanWorkerPoolSizeOverride and parallelScanThresholdOverride tune findTasksParallel's
+	// concurrency. Left at zero, their accessors fall back to the matching default* constant.
+	scanWorkerPoolSizeOverride    int
+	parallelScanThresholdOverride uint64
+	// gatherProofInputsConcurrencyOverride tunes gatherProofInputsBatch's concurrency. Left at
+	// zero, its accessor falls back to defaultGatherProofInputsConcurrency.
+	gatherProofInputsConcurrencyOverride int
+}
+
+// SetNonceTracker attaches an EthNonceTracker so findTasks reads each account's latest
+// delivered nonce from its in-memory cache instead of calling BasicInboundChannel.Nonce
+// directly on every scan. Leaving it unset falls back to the old per-scan eth_call per account.
+func (s *Scanner) SetNonceTracker(nonceTracker *EthNonceTracker) {
+	s.nonceTracker = nonceTracker
+}
+
+// SetScanConcurrency overrides the worker-pool size used by findTasksParallel and the block
+// count findTasksImpl scans serially before handing off to findTasksParallel. Leaving either
+// argument at zero keeps that setting's default.
+func (s *Scanner) SetScanConcurrency(scanWorkerPoolSize int, parallelScanThreshold uint64) {
+	s.scanWorkerPoolSizeOverride = scanWorkerPoolSize
+	s.parallelScanThresholdOverride = parallelScanThreshold
+}
+
+// SetGatherProofInputsConcurrency overrides how many PersistedValidationData/parachain-head
+// lookups gatherProofInputsBatch runs at once. Leaving it at zero keeps the default.
+func (s *Scanner) SetGatherProofInputsConcurrency(gatherProofInputsConcurrency int) {
+	s.gatherProofInputsConcurrencyOverride = gatherProofInputsConcurrency
 }
 
 // Scans for all parachain message commitments that need to be relayed and can be proven
@@ -95,9 +133,15 @@ func (s *Scanner) findTasks(
 
 	basicChannelAccountNoncesToFind := make(map[types.AccountID]uint64, len(s.accounts))
 	for _, account := range s.accounts {
-		ethBasicNonce, err := basicContract.Nonce(&options, account)
-		if err != nil {
-			return nil, err
+		var ethBasicNonce uint64
+		if s.nonceTracker != nil {
+			ethBasicNonce, _ = s.nonceTracker.Get(account)
+		} else {
+			var err error
+			ethBasicNonce, err = basicContract.Nonce(&options, account)
+			if err != nil {
+				return nil, err
+			}
 		}
 		log.WithFields(log.Fields{
 			"nonce":   ethBasicNonce,
@@ -142,11 +186,35 @@ func (s *Scanner) findTasks(
 		return nil, err
 	}
 
-	s.gatherProofInputs(tasks)
+	err = s.gatherProofInputsBatch(tasks)
+	if err != nil {
+		return nil, err
+	}
 
 	return tasks, nil
 }
 
+// scanWorkerPoolSize returns the number of blocks findTasksParallel fetches concurrently,
+// falling back to defaultScanWorkerPoolSize when SetScanConcurrency leaves it unset.
+func (s *Scanner) scanWorkerPoolSize() int {
+	if s.scanWorkerPoolSizeOverride > 0 {
+		return s.scanWorkerPoolSizeOverride
+	}
+	return defaultScanWorkerPoolSize
+}
+
+// parallelScanThreshold returns how many blocks findTasksImpl scans serially before handing
+// the remainder of the scan off to findTasksParallel, falling back to
+// defaultParallelScanThreshold when SetScanConcurrency leaves it unset. Most scans only need to
+// walk back a handful of blocks, since channels commit regularly, so paying for a worker pool
+// isn't worthwhile until the scan window is shown to be large.
+func (s *Scanner) parallelScanThreshold() uint64 {
+	if s.parallelScanThresholdOverride > 0 {
+		return s.parallelScanThresholdOverride
+	}
+	return defaultParallelScanThreshold
+}
+
 // Searches for all lost commitments on the basic channel from the given parachain block number backwards
 // until it finds the given nonces
 func (s *Scanner) findTasksImpl(
@@ -176,90 +244,235 @@ func (s *Scanner) findTasksImpl(
 
 	var tasks []*Task
 
-	for !scanBasicChannelDone && currentBlockNumber > 0 {
+	// Scan serially until either the scan finishes or parallelScanThreshold blocks have been
+	// walked without finishing, at which point the window is known to be large enough that a
+	// worker pool pays for its own overhead.
+	serialFloor := uint64(0)
+	if currentBlockNumber > s.parallelScanThreshold() {
+		serialFloor = currentBlockNumber - s.parallelScanThreshold()
+	}
+
+	for !scanBasicChannelDone && currentBlockNumber > serialFloor && currentBlockNumber > 0 {
 		log.WithFields(log.Fields{
 			"blockNumber": currentBlockNumber,
 		}).Debug("Checking header")
 
-		blockHash, err := s.paraConn.API().RPC.Chain.GetBlockHash(currentBlockNumber)
+		apply, err := s.fetchBlockScan(ctx, currentBlockNumber, basicChannelAccountNonces, basicChannelScanAccounts, &scanBasicChannelDone)
 		if err != nil {
-			return nil, fmt.Errorf("fetch blockhash for block %v: %w", currentBlockNumber, err)
+			return nil, err
 		}
 
-		header, err := s.paraConn.API().RPC.Chain.GetHeader(blockHash)
+		task, err := apply()
 		if err != nil {
-			return nil, fmt.Errorf("fetch header for %v: %w", blockHash.Hex(), err)
+			return nil, err
+		}
+		if task != nil {
+			tasks = append(tasks, task)
 		}
 
-		digestItems, err := ExtractAuxiliaryDigestItems(header.Digest)
+		currentBlockNumber--
+	}
+
+	if !scanBasicChannelDone && currentBlockNumber > 0 {
+		parallelTasks, err := s.findTasksParallel(ctx, currentBlockNumber, basicChannelAccountNonces, basicChannelScanAccounts, &scanBasicChannelDone)
 		if err != nil {
 			return nil, err
 		}
+		tasks = append(tasks, parallelTasks...)
+	}
 
-		if len(digestItems) == 0 {
-			currentBlockNumber--
-			continue
+	// sort tasks by ascending block number
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].Header.Number < tasks[j].Header.Number
+	})
+
+	return tasks, nil
+}
+
+// scanBatch is one (batchStart, batchSize) step of a backward block scan: the batch covers blocks
+// [batchStart-batchSize+1, batchStart].
+type scanBatch struct {
+	BatchStart uint64
+	BatchSize  uint64
+}
+
+// scanBatches computes the sequence of batches findTasksParallel walks to scan backward from
+// startBlockNumber down to block 1 in batches of at most poolSize blocks. It's split out from
+// findTasksParallel so this arithmetic - easy to get wrong with uint64 underflow once the final
+// batch is smaller than poolSize - can be table-tested without also driving the RPC calls
+// findTasksParallel makes per batch. Returns nil if startBlockNumber is 0.
+func scanBatches(startBlockNumber, poolSize uint64) []scanBatch {
+	var batches []scanBatch
+
+	for batchStart := startBlockNumber; batchStart > 0; {
+		batchSize := poolSize
+		if batchSize > batchStart {
+			batchSize = batchStart
 		}
+		batches = append(batches, scanBatch{BatchStart: batchStart, BatchSize: batchSize})
 
-		basicChannelProofs := make([]BundleProof, 0, len(basicChannelAccountNonces))
+		// batchStart -= poolSize would underflow once batchStart <= poolSize, since both are
+		// uint64; this batch already reached block 1 (batchSize was clamped to batchStart above),
+		// so there's nothing left to scan.
+		if batchStart <= poolSize {
+			break
+		}
+		batchStart -= poolSize
+	}
 
-		events, err := s.eventQueryClient.QueryEvent(ctx, s.config.Parachain.Endpoint, blockHash)
-		if err != nil {
-			return nil, fmt.Errorf("query events: %w", err)
+	return batches
+}
+
+// findTasksParallel continues findTasksImpl's backward scan from startBlockNumber down to
+// block 1, in batches of scanWorkerPoolSize blocks. Within a batch, the per-block RPCs
+// (GetBlockHash, GetHeader, QueryEvent) run concurrently via fetchBlockScan, but the thunks it
+// returns are applied one at a time in descending block-number order, so
+// scanBasicChannelDone/basicChannelScanAccounts transition exactly as they would under the
+// serial loop and "first match wins" is preserved. An error from any worker aborts the batch
+// via the errgroup, so the sink never applies a partial batch.
+func (s *Scanner) findTasksParallel(
+	ctx context.Context,
+	startBlockNumber uint64,
+	basicChannelAccountNonces map[types.AccountID]uint64,
+	basicChannelScanAccounts map[types.AccountID]bool,
+	scanBasicChannelDone *bool,
+) ([]*Task, error) {
+	poolSize := uint64(s.scanWorkerPoolSize())
+
+	var tasks []*Task
+
+	for _, batch := range scanBatches(startBlockNumber, poolSize) {
+		if *scanBasicChannelDone {
+			break
+		}
+
+		log.WithFields(log.Fields{
+			"batchStart": batch.BatchStart,
+			"batchSize":  batch.BatchSize,
+		}).Debug("Fetching batch of parachain blocks in parallel")
+
+		applies := make([]func() (*Task, error), batch.BatchSize)
+
+		eg, egCtx := errgroup.WithContext(ctx)
+		for i := uint64(0); i < batch.BatchSize; i++ {
+			i := i
+			blockNumber := batch.BatchStart - i
+			eg.Go(func() error {
+				apply, err := s.fetchBlockScan(egCtx, blockNumber, basicChannelAccountNonces, basicChannelScanAccounts, scanBasicChannelDone)
+				if err != nil {
+					return err
+				}
+				applies[i] = apply
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return nil, err
+		}
+
+		for i := uint64(0); i < batch.BatchSize && !*scanBasicChannelDone; i++ {
+			task, err := applies[i]()
+			if err != nil {
+				return nil, err
+			}
+			if task != nil {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+
+	return tasks, nil
+}
+
+// fetchBlockScan issues the RPCs needed to evaluate a single parachain block (GetBlockHash,
+// GetHeader, QueryEvent) and returns a thunk that applies the result against the shared scan
+// state (basicChannelAccountNonces/basicChannelScanAccounts/scanBasicChannelDone). The thunk
+// itself does no RPC work, so callers may fetch several blocks' data concurrently and still
+// apply the thunks serially, in descending block-number order, to preserve "first match wins"
+// semantics.
+func (s *Scanner) fetchBlockScan(
+	ctx context.Context,
+	blockNumber uint64,
+	basicChannelAccountNonces map[types.AccountID]uint64,
+	basicChannelScanAccounts map[types.AccountID]bool,
+	scanBasicChannelDone *bool,
+) (func() (*Task, error), error) {
+	blockHash, err := s.paraConn.API().RPC.Chain.GetBlockHash(blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("fetch blockhash for block %v: %w", blockNumber, err)
+	}
+
+	header, err := s.paraConn.API().RPC.Chain.GetHeader(blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("fetch header for %v: %w", blockHash.Hex(), err)
+	}
+
+	digestItems, err := ExtractAuxiliaryDigestItems(header.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Queried unconditionally, even if digestItems turns out empty, so that every block
+	// assigned to a worker needs exactly one round trip per RPC and batches stay easy to reason
+	// about.
+	events, err := s.eventQueryClient.QueryEvent(ctx, s.config.Parachain.Endpoint, blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+
+	return func() (*Task, error) {
+		if len(digestItems) == 0 {
+			return nil, nil
 		}
 
+		basicChannelProofs := make([]BundleProof, 0, len(basicChannelAccountNonces))
+
 		for _, digestItem := range digestItems {
 			if !digestItem.IsCommitment {
 				continue
 			}
 
-			if !scanBasicChannelDone {
-				if events == nil {
-					return nil, fmt.Errorf("event basicOutboundChannel.Committed not found in block")
-				}
+			if *scanBasicChannelDone {
+				continue
+			}
 
-				digestItemHash := digestItem.AsCommitment.Hash
-				if events.Hash != digestItemHash {
-					return nil, fmt.Errorf("basic channel commitment hash in digest item does not match the one in the Committed event")
-				}
+			if events == nil {
+				return nil, fmt.Errorf("event basicOutboundChannel.Committed not found in block")
+			}
 
-				// For basic channel commit hash is the merkle root calculated from bundles
-				// https://github.com/Snowfork/snowbridge/blob/75a475cbf8fc8e13577ad6b773ac452b2bf82fbb/parachain/pallets/basic-channel/src/outbound/mod.rs#L275-L277
-				// to verify it we fetch bundle proof from parachain
-				result, err := scanForBasicChannelProofs(
-					s.paraConn.API(),
-					digestItemHash,
-					basicChannelAccountNonces,
-					basicChannelScanAccounts,
-					events.Bundles,
-				)
-				if err != nil {
-					return nil, err
-				}
-				basicChannelProofs = result.proofs
-				scanBasicChannelDone = result.scanDone
+			digestItemHash := digestItem.AsCommitment.Hash
+			if events.Hash != digestItemHash {
+				return nil, fmt.Errorf("basic channel commitment hash in digest item does not match the one in the Committed event")
 			}
-		}
 
-		if len(basicChannelProofs) > 0 {
-			task := Task{
-				Header:             header,
-				BasicChannelProofs: &basicChannelProofs,
-				ProofInput:         nil,
-				ProofOutput:        nil,
+			// For basic channel commit hash is the merkle root calculated from bundles
+			// https://github.com/Snowfork/snowbridge/blob/75a475cbf8fc8e13577ad6b773ac452b2bf82fbb/parachain/pallets/basic-channel/src/outbound/mod.rs#L275-L277
+			// to verify it we fetch bundle proof from parachain
+			result, err := scanForBasicChannelProofs(
+				s.paraConn.API(),
+				digestItemHash,
+				basicChannelAccountNonces,
+				basicChannelScanAccounts,
+				events.Bundles,
+			)
+			if err != nil {
+				return nil, err
 			}
-			tasks = append(tasks, &task)
+			basicChannelProofs = result.proofs
+			*scanBasicChannelDone = result.scanDone
 		}
 
-		currentBlockNumber--
-	}
-
-	// sort tasks by ascending block number
-	sort.SliceStable(tasks, func(i, j int) bool {
-		return tasks[i].Header.Number < tasks[j].Header.Number
-	})
+		if len(basicChannelProofs) == 0 {
+			return nil, nil
+		}
 
-	return tasks, nil
+		return &Task{
+			Header:             header,
+			BasicChannelProofs: &basicChannelProofs,
+			ProofInput:         nil,
+			ProofOutput:        nil,
+		}, nil
+	}, nil
 }
 
 type PersistedValidationData struct {
@@ -269,91 +482,199 @@ type PersistedValidationData struct {
 	MaxPOVSize             uint32
 }
 
-// For each task, gatherProofInputs will search to find the relay chain block
-// in which that header was included as well as the parachain heads for that block.
-func (s *Scanner) gatherProofInputs(
-	tasks []*Task,
-) error {
-	for _, task := range tasks {
+// The process for finalizing a backed parachain header times out after these many blocks:
+const FinalizationTimeout = 4
 
-		log.WithFields(log.Fields{
-			"ParaBlockNumber": task.Header.Number,
-		}).Debug("Gathering proof inputs for parachain header")
+// defaultGatherProofInputsConcurrency is used whenever SetGatherProofInputsConcurrency leaves
+// gatherProofInputsConcurrency unset (zero value).
+const defaultGatherProofInputsConcurrency = 8
 
-		relayBlockNumber, err := s.findInclusionBlockNumber(uint64(task.Header.Number))
-		if err != nil {
-			return fmt.Errorf("find inclusion block number for parachain block %v: %w", task.Header.Number, err)
-		}
+// gatherProofInputsConcurrency returns how many PersistedValidationData/parachain-head lookups
+// gatherProofInputsBatch runs at once, falling back to defaultGatherProofInputsConcurrency when
+// SetGatherProofInputsConcurrency leaves it unset.
+func (s *Scanner) gatherProofInputsConcurrency() int {
+	if s.gatherProofInputsConcurrencyOverride > 0 {
+		return s.gatherProofInputsConcurrencyOverride
+	}
+	return defaultGatherProofInputsConcurrency
+}
 
-		relayBlockHash, err := s.relayConn.API().RPC.Chain.GetBlockHash(relayBlockNumber)
-		if err != nil {
-			return fmt.Errorf("fetch relaychain block hash: %w", err)
+// gatherProofInputsBatch searches, for every task, the relay chain block in which that task's
+// parachain header was included (finalized) - which usually happens 2-3 blocks after the relay
+// chain block in which it was backed - and the parachain heads at that block, then assigns
+// the result to task.ProofInput.
+//
+// Naively this is up to 2*FinalizationTimeout*len(tasks) relay-chain round-trips (GetBlockHash +
+// FetchParachainHead per FinalizationTimeout candidate) plus one FetchParachainHeads call per
+// task. Instead:
+//  1. every task's PersistedValidationData is fetched concurrently, bounded by
+//     gatherProofInputsConcurrency;
+//  2. the FinalizationTimeout candidate relay blocks implied by those are deduplicated across
+//     tasks, since adjacent parachain blocks usually share an inclusion window;
+//  3. each unique candidate's parachain head is fetched at most once, concurrently, and cached
+//     by relay block number;
+//  4. each task's inclusion block is resolved from that cache, and FetchParachainHeads is only
+//     called once per distinct inclusion block actually used, not once per task.
+func (s *Scanner) gatherProofInputsBatch(tasks []*Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"tasks": len(tasks),
+	}).Debug("Gathering proof inputs for parachain headers")
+
+	validationDataByBlock, err := s.fetchValidationDataBatch(tasks)
+	if err != nil {
+		return err
+	}
+
+	candidateSet := make(map[uint64]struct{})
+	for _, validationData := range validationDataByBlock {
+		relayParentNumber := uint64(validationData.RelayParentNumber)
+		for i := relayParentNumber + 1; i < relayParentNumber+1+FinalizationTimeout; i++ {
+			candidateSet[i] = struct{}{}
 		}
+	}
+	candidates := make([]uint64, 0, len(candidateSet))
+	for relayBlockNumber := range candidateSet {
+		candidates = append(candidates, relayBlockNumber)
+	}
 
-		parachainHeads, err := s.relayConn.FetchParachainHeads(relayBlockHash)
-		if err != nil {
-			return fmt.Errorf("fetch parachain heads: %w", err)
+	paraHeadsByRelayBlock, err := s.fetchParaHeadsBatch(candidates)
+	if err != nil {
+		return err
+	}
+
+	proofInputsByRelayBlock := make(map[uint64]*ProofInput, len(candidates))
+
+	for _, task := range tasks {
+		paraBlockNumber := uint64(task.Header.Number)
+		relayParentNumber := uint64(validationDataByBlock[paraBlockNumber].RelayParentNumber)
+
+		relayBlockNumber, found := uint64(0), false
+		for i := relayParentNumber + 1; i < relayParentNumber+1+FinalizationTimeout; i++ {
+			if paraHead, ok := paraHeadsByRelayBlock[i]; ok && paraBlockNumber == uint64(paraHead.Number) {
+				relayBlockNumber, found = i, true
+				break
+			}
 		}
+		if !found {
+			return fmt.Errorf("find inclusion block number for parachain block %v: scan terminated", task.Header.Number)
+		}
+
+		proofInput, ok := proofInputsByRelayBlock[relayBlockNumber]
+		if !ok {
+			relayBlockHash, err := s.relayConn.API().RPC.Chain.GetBlockHash(relayBlockNumber)
+			if err != nil {
+				return fmt.Errorf("fetch relaychain block hash: %w", err)
+			}
+
+			parachainHeads, err := s.relayConn.FetchParachainHeads(relayBlockHash)
+			if err != nil {
+				return fmt.Errorf("fetch parachain heads: %w", err)
+			}
 
-		task.ProofInput = &ProofInput{
-			ParaID:           s.paraID,
-			RelayBlockNumber: relayBlockNumber,
-			ParaHeads:        parachainHeads,
+			proofInput = &ProofInput{
+				ParaID:           s.paraID,
+				RelayBlockNumber: relayBlockNumber,
+				ParaHeads:        parachainHeads,
+			}
+			proofInputsByRelayBlock[relayBlockNumber] = proofInput
 		}
+
+		task.ProofInput = proofInput
 	}
 
 	return nil
 }
 
-// The process for finalizing a backed parachain header times out after these many blocks:
-const FinalizationTimeout = 4
-
-// Find the relaychain block in which a parachain header was included (finalized). This usually happens
-// 2-3 blocks after the relaychain block in which the parachain header was backed.
-func (s *Scanner) findInclusionBlockNumber(
-	paraBlockNumber uint64,
-) (uint64, error) {
+// fetchValidationDataBatch fetches every task's PersistedValidationData concurrently, bounded
+// by gatherProofInputsConcurrency, and returns them keyed by parachain block number.
+func (s *Scanner) fetchValidationDataBatch(tasks []*Task) (map[uint64]PersistedValidationData, error) {
 	validationDataKey, err := types.CreateStorageKey(s.paraConn.Metadata(), "ParachainSystem", "ValidationData", nil, nil)
 	if err != nil {
-		return 0, fmt.Errorf("create storage key: %w", err)
+		return nil, fmt.Errorf("create storage key: %w", err)
 	}
 
-	paraBlockHash, err := s.paraConn.API().RPC.Chain.GetBlockHash(paraBlockNumber)
-	if err != nil {
-		return 0, fmt.Errorf("fetch parachain block hash: %w", err)
-	}
+	var mu sync.Mutex
+	results := make(map[uint64]PersistedValidationData, len(tasks))
 
-	var validationData PersistedValidationData
-	ok, err := s.paraConn.API().RPC.State.GetStorage(validationDataKey, &validationData, paraBlockHash)
-	if err != nil {
-		return 0, fmt.Errorf("fetch PersistedValidationData for block %v: %w", paraBlockHash.Hex(), err)
+	sem := make(chan struct{}, s.gatherProofInputsConcurrency())
+	var eg errgroup.Group
+	for _, task := range tasks {
+		paraBlockNumber := uint64(task.Header.Number)
+		eg.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			paraBlockHash, err := s.paraConn.API().RPC.Chain.GetBlockHash(paraBlockNumber)
+			if err != nil {
+				return fmt.Errorf("fetch parachain block hash: %w", err)
+			}
+
+			var validationData PersistedValidationData
+			ok, err := s.paraConn.API().RPC.State.GetStorage(validationDataKey, &validationData, paraBlockHash)
+			if err != nil {
+				return fmt.Errorf("fetch PersistedValidationData for block %v: %w", paraBlockHash.Hex(), err)
+			}
+			if !ok {
+				return fmt.Errorf("PersistedValidationData not found for block %v", paraBlockHash.Hex())
+			}
+
+			mu.Lock()
+			results[paraBlockNumber] = validationData
+			mu.Unlock()
+			return nil
+		})
 	}
-	if !ok {
-		return 0, fmt.Errorf("PersistedValidationData not found for block %v", paraBlockHash.Hex())
+	if err := eg.Wait(); err != nil {
+		return nil, err
 	}
 
-	startBlock := validationData.RelayParentNumber + 1
-	for i := validationData.RelayParentNumber + 1; i < startBlock+FinalizationTimeout; i++ {
-		relayBlockHash, err := s.relayConn.API().RPC.Chain.GetBlockHash(uint64(i))
-		if err != nil {
-			return 0, fmt.Errorf("fetch relaychain block hash: %w", err)
-		}
+	return results, nil
+}
 
-		var paraHead types.Header
-		ok, err := s.relayConn.FetchParachainHead(relayBlockHash, s.paraID, &paraHead)
-		if err != nil {
-			return 0, fmt.Errorf("fetch head for parachain %v at block %v: %w", s.paraID, relayBlockHash.Hex(), err)
-		}
-		if !ok {
-			return 0, fmt.Errorf("parachain %v is not registered", s.paraID)
-		}
+// fetchParaHeadsBatch fetches this scanner's own parachain head at every given relay block
+// number concurrently, bounded by gatherProofInputsConcurrency, and returns them keyed by relay
+// block number.
+func (s *Scanner) fetchParaHeadsBatch(relayBlockNumbers []uint64) (map[uint64]types.Header, error) {
+	var mu sync.Mutex
+	results := make(map[uint64]types.Header, len(relayBlockNumbers))
+
+	sem := make(chan struct{}, s.gatherProofInputsConcurrency())
+	var eg errgroup.Group
+	for _, relayBlockNumber := range relayBlockNumbers {
+		relayBlockNumber := relayBlockNumber
+		eg.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			relayBlockHash, err := s.relayConn.API().RPC.Chain.GetBlockHash(relayBlockNumber)
+			if err != nil {
+				return fmt.Errorf("fetch relaychain block hash: %w", err)
+			}
 
-		if paraBlockNumber == uint64(paraHead.Number) {
-			return uint64(i), nil
-		}
+			var paraHead types.Header
+			ok, err := s.relayConn.FetchParachainHead(relayBlockHash, s.paraID, &paraHead)
+			if err != nil {
+				return fmt.Errorf("fetch head for parachain %v at block %v: %w", s.paraID, relayBlockHash.Hex(), err)
+			}
+			if !ok {
+				return fmt.Errorf("parachain %v is not registered", s.paraID)
+			}
+
+			mu.Lock()
+			results[relayBlockNumber] = paraHead
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
 	}
 
-	return 0, fmt.Errorf("scan terminated")
+	return results, nil
 }
 
 func scanForBasicChannelProofs(
@@ -0,0 +1,90 @@
+package parachain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScanBatches(t *testing.T) {
+	tests := []struct {
+		name             string
+		startBlockNumber uint64
+		poolSize         uint64
+		expected         []scanBatch
+	}{
+		{
+			name:             "zero start block yields no batches",
+			startBlockNumber: 0,
+			poolSize:         8,
+			expected:         nil,
+		},
+		{
+			name:             "start block smaller than pool size",
+			startBlockNumber: 5,
+			poolSize:         8,
+			expected: []scanBatch{
+				{BatchStart: 5, BatchSize: 5},
+			},
+		},
+		{
+			name:             "start block exactly one pool size",
+			startBlockNumber: 8,
+			poolSize:         8,
+			expected: []scanBatch{
+				{BatchStart: 8, BatchSize: 8},
+			},
+		},
+		{
+			name:             "start block evenly divisible by pool size",
+			startBlockNumber: 16,
+			poolSize:         8,
+			expected: []scanBatch{
+				{BatchStart: 16, BatchSize: 8},
+				{BatchStart: 8, BatchSize: 8},
+			},
+		},
+		{
+			name:             "final batch smaller than pool size",
+			startBlockNumber: 20,
+			poolSize:         8,
+			expected: []scanBatch{
+				{BatchStart: 20, BatchSize: 8},
+				{BatchStart: 12, BatchSize: 8},
+				{BatchStart: 4, BatchSize: 4},
+			},
+		},
+		{
+			name:             "pool size of one steps down by one block at a time",
+			startBlockNumber: 3,
+			poolSize:         1,
+			expected: []scanBatch{
+				{BatchStart: 3, BatchSize: 1},
+				{BatchStart: 2, BatchSize: 1},
+				{BatchStart: 1, BatchSize: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batches := scanBatches(tt.startBlockNumber, tt.poolSize)
+			if !reflect.DeepEqual(batches, tt.expected) {
+				t.Fatalf("scanBatches(%d, %d) = %+v, expected %+v", tt.startBlockNumber, tt.poolSize, batches, tt.expected)
+			}
+
+			// The last batch must always reach block 1, and no batch may start at 0 - the
+			// underflow this test guards against produces exactly that kind of garbage batch.
+			if len(batches) > 0 {
+				last := batches[len(batches)-1]
+				if last.BatchStart-last.BatchSize+1 != 1 {
+					t.Fatalf("last batch %+v does not reach block 1", last)
+				}
+			}
+			for _, b := range batches {
+				if b.BatchStart == 0 || b.BatchSize == 0 || b.BatchSize > b.BatchStart {
+					t.Fatalf("invalid batch %+v", b)
+				}
+			}
+		})
+	}
+}
@@ -9,17 +9,37 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/snowfork/polkadot-ethereum/relayer/chain"
 	"github.com/snowfork/polkadot-ethereum/relayer/chain/ethereum"
 	"github.com/snowfork/polkadot-ethereum/relayer/contracts/lightclientbridge"
+	"github.com/snowfork/polkadot-ethereum/relayer/metrics"
 	"github.com/snowfork/polkadot-ethereum/relayer/workers/beefyrelayer/store"
 )
 
 const MaxMessagesPerSend = 10
 
+// checkpointChain and checkpointListenerName identify this listener's row in
+// the store.Checkpoint table, keyed by (chain, listener_name).
+const (
+	checkpointChain        = "ethereum"
+	checkpointListenerName = "beefy-ethereum-listener"
+)
+
+// trackedBlock records the canonical chain as observed by the listener at the
+// time it was seen, along with the event transactions witnessed in it. It lets
+// pollEventsAndHeaders detect when a later reorg has dropped a block it
+// already acted on.
+type trackedBlock struct {
+	number uint64
+	hash   common.Hash
+	parent common.Hash
+	txs    []common.Hash
+}
+
 // Listener streams the Ethereum blockchain for application events
 type BeefyEthereumListener struct {
 	ethereumConfig    *ethereum.Config
@@ -31,6 +51,33 @@ type BeefyEthereumListener struct {
 	headers           chan<- chain.Header
 	blockWaitPeriod   uint64
 	log               *logrus.Entry
+	// recentBlocks is a rolling ring buffer covering at least
+	// descendantsUntilFinal blocks of chain history, used to detect reorgs
+	// before a block is treated as final.
+	recentBlocks          []trackedBlock
+	descendantsUntilFinal uint64
+	metrics               *metrics.Metrics
+	// eventScanWindow and eventScanMaxWindow bound the eth_getLogs window used by
+	// scanLogsInWindows. A zero eventScanWindow falls back to defaultScanWindow; a zero
+	// eventScanMaxWindow caps the window at its initial size.
+	eventScanWindow    uint64
+	eventScanMaxWindow uint64
+}
+
+// SetMetrics attaches a Metrics instance the listener will report to. It's optional; a nil
+// metrics field is a no-op everywhere it's read.
+func (li *BeefyEthereumListener) SetMetrics(m *metrics.Metrics) {
+	li.metrics = m
+}
+
+// SetEventScanWindow configures the eth_getLogs window used by the historical log scanners.
+// initialWindow is the starting window size, halved (down to a single block) each time a provider
+// rejects a range as too large, and maxWindow is the ceiling it grows back towards on success.
+// Leaving initialWindow at zero falls back to defaultScanWindow; leaving maxWindow at zero (or
+// below initialWindow) caps the window at initialWindow.
+func (li *BeefyEthereumListener) SetEventScanWindow(initialWindow, maxWindow uint64) {
+	li.eventScanWindow = initialWindow
+	li.eventScanMaxWindow = maxWindow
 }
 
 func NewBeefyEthereumListener(ethereumConfig *ethereum.Config, ethereumConn *ethereum.Connection, beefyDB *store.Database,
@@ -69,9 +116,15 @@ func (li *BeefyEthereumListener) Start(cxt context.Context, eg *errgroup.Group,
 	if err != nil {
 		return err
 	}
-	if uint64(li.ethereumConfig.StartBlock) < blockNumber {
-		li.log.Info(fmt.Sprintf("Syncing Relayer from block %d...", li.ethereumConfig.StartBlock))
-		err := li.pollHistoricEventsAndHeaders(cxt)
+
+	resumeFrom, err := li.resumeBlock(cxt)
+	if err != nil {
+		return err
+	}
+
+	if resumeFrom < blockNumber {
+		li.log.Info(fmt.Sprintf("Syncing Relayer from block %d...", resumeFrom))
+		err := li.pollHistoricEventsAndHeaders(cxt, resumeFrom)
 		if err != nil {
 			return err
 		}
@@ -88,17 +141,119 @@ func (li *BeefyEthereumListener) Start(cxt context.Context, eg *errgroup.Group,
 	return nil
 }
 
-func (li *BeefyEthereumListener) pollHistoricEventsAndHeaders(ctx context.Context) error {
-	// Load starting block number and latest block number
-	blockNumber := li.ethereumConfig.StartBlock
+// maxAncestorSearchDepth bounds findCommonAncestor's backward walk, so a checkpoint referencing a
+// hash the node has no record of at all (rather than just an abandoned fork) fails fast instead of
+// walking all the way to genesis.
+const maxAncestorSearchDepth = 4096
+
+// resumeBlock returns the block number pollHistoricEventsAndHeaders should resume scanning
+// from: the greater of the configured StartBlock and one past the highest block still on the
+// canonical chain at or below the last checkpoint. If the checkpointed block hash no longer
+// matches the canonical chain (the relayer was stopped mid-reorg), findCommonAncestor walks
+// back to find where the two chains actually diverged, so a reorg deeper than one block still
+// gets its full gap rescanned.
+func (li *BeefyEthereumListener) resumeBlock(ctx context.Context) (uint64, error) {
+	checkpoint, ok := li.beefyDB.GetCheckpoint(checkpointChain, checkpointListenerName)
+	if !ok {
+		return uint64(li.ethereumConfig.StartBlock), nil
+	}
+
+	commonAncestor, err := li.findCommonAncestor(ctx, checkpoint.LastScannedBlock, checkpoint.LastScannedHash)
+	if err != nil {
+		return 0, err
+	}
+
+	resumeFrom := commonAncestor + 1
+	if uint64(li.ethereumConfig.StartBlock) > resumeFrom {
+		resumeFrom = uint64(li.ethereumConfig.StartBlock)
+	}
+	return resumeFrom, nil
+}
+
+// findCommonAncestor returns the highest block at or below checkpointBlock whose hash still
+// matches the canonical chain. If checkpointHash is no longer canonical, it re-fetches the
+// abandoned checkpoint block by hash (full nodes keep a block's header for a while after it
+// falls off the canonical chain, even though HeaderByNumber at its height now returns something
+// else) and walks its ParentHash chain backward one block at a time, checking each ancestor
+// against the canonical header at the same height, until one matches - that's the true fork
+// point, however many blocks deep the reorg went. If the node no longer has the abandoned fork's
+// blocks at all, it falls back to the checkpoint's immediate parent, matching the best rewind a
+// single-block-deep reorg would need.
+func (li *BeefyEthereumListener) findCommonAncestor(ctx context.Context, checkpointBlock uint64, checkpointHash common.Hash) (uint64, error) {
+	canonicalHeader, err := li.ethereumConn.GetClient().HeaderByNumber(ctx, big.NewInt(int64(checkpointBlock)))
+	if err != nil {
+		return 0, fmt.Errorf("fetch canonical header at checkpointed block %d: %w", checkpointBlock, err)
+	}
+	if canonicalHeader.Hash() == checkpointHash {
+		return checkpointBlock, nil
+	}
+
+	if checkpointBlock == 0 {
+		return 0, nil
+	}
+
+	li.log.WithFields(logrus.Fields{
+		"checkpointBlock": checkpointBlock,
+		"checkpointHash":  checkpointHash.Hex(),
+	}).Warn("Checkpointed block is no longer canonical, walking back to find the common ancestor")
+
+	staleHeader, err := li.ethereumConn.GetClient().HeaderByHash(ctx, checkpointHash)
+	if err != nil {
+		li.log.WithError(err).Warn("Could not fetch the abandoned checkpoint block by hash, rewinding to its parent only")
+		return checkpointBlock - 1, nil
+	}
+
+	ancestorHash := staleHeader.ParentHash
+	for blockNumber := checkpointBlock - 1; checkpointBlock-blockNumber <= maxAncestorSearchDepth; {
+		canonicalAtHeight, err := li.ethereumConn.GetClient().HeaderByNumber(ctx, big.NewInt(int64(blockNumber)))
+		if err != nil {
+			return 0, fmt.Errorf("fetch canonical header at block %d: %w", blockNumber, err)
+		}
+		if canonicalAtHeight.Hash() == ancestorHash {
+			return blockNumber, nil
+		}
+
+		if blockNumber == 0 {
+			break
+		}
+
+		staleAncestor, err := li.ethereumConn.GetClient().HeaderByHash(ctx, ancestorHash)
+		if err != nil {
+			li.log.WithError(err).WithField("blockNumber", blockNumber).Warn("Could not fetch an abandoned ancestor block by hash, stopping ancestor walk here")
+			return blockNumber, nil
+		}
+		ancestorHash = staleAncestor.ParentHash
+		blockNumber--
+	}
+
+	return 0, fmt.Errorf("no common ancestor found for checkpointed block %d within %d blocks", checkpointBlock, maxAncestorSearchDepth)
+}
+
+func (li *BeefyEthereumListener) pollHistoricEventsAndHeaders(ctx context.Context, blockNumber uint64) error {
+	// Load latest block number
 	latestBlockNumber, err := li.ethereumConn.GetClient().BlockNumber(ctx)
 	if err != nil {
 		return err
 	}
 
+	li.backfillPayloadHashes()
 	li.processHistoricalInitialVerificationSuccessfulEvents(ctx, blockNumber, latestBlockNumber)
 	li.processHistoricalFinalVerificationSuccessfulEvents(ctx, blockNumber, latestBlockNumber)
 
+	latestHeader, err := li.ethereumConn.GetClient().HeaderByNumber(ctx, big.NewInt(int64(latestBlockNumber)))
+	if err != nil {
+		return err
+	}
+	li.dbMessages <- store.NewCheckpointCmd(store.Checkpoint{
+		Chain:            checkpointChain,
+		ListenerName:     checkpointListenerName,
+		LastScannedBlock: latestBlockNumber,
+		LastScannedHash:  latestHeader.Hash(),
+	})
+	if li.metrics != nil {
+		li.metrics.BeefyLastProcessedBlock.WithLabelValues(checkpointChain).Set(float64(latestBlockNumber))
+	}
+
 	return nil
 }
 
@@ -115,9 +270,137 @@ func (li *BeefyEthereumListener) pollEventsAndHeaders(ctx context.Context, desce
 		case gethheader := <-headers:
 			blockNumber := gethheader.Number.Uint64()
 
-			li.processInitialVerificationSuccessfulEvents(ctx, blockNumber)
+			if err := li.reconcileReorg(ctx, gethheader, descendantsUntilFinal); err != nil {
+				li.log.WithError(err).Error("Failed to reconcile possible Ethereum reorg")
+			}
+
+			eventTxs := li.processInitialVerificationSuccessfulEvents(ctx, blockNumber)
+			eventTxs = append(eventTxs, li.processFinalVerificationSuccessfulEvents(ctx, blockNumber)...)
+			li.recordBlock(gethheader, eventTxs)
+
 			li.forwardWitnessedBeefyCommitment(ctx, blockNumber, descendantsUntilFinal)
-			li.processInitialVerificationSuccessfulEvents(ctx, blockNumber)
+
+			li.advanceCheckpoint(blockNumber, descendantsUntilFinal)
+		}
+	}
+}
+
+// advanceCheckpoint records the most recently *final* block (i.e. at least
+// descendantsUntilFinal deep) as the new checkpoint, using the buffered
+// header so the recorded hash is known to match what was actually processed.
+func (li *BeefyEthereumListener) advanceCheckpoint(headBlockNumber, descendantsUntilFinal uint64) {
+	if headBlockNumber < descendantsUntilFinal {
+		return
+	}
+	finalBlockNumber := headBlockNumber - descendantsUntilFinal
+
+	for _, tracked := range li.recentBlocks {
+		if tracked.number == finalBlockNumber {
+			li.dbMessages <- store.NewCheckpointCmd(store.Checkpoint{
+				Chain:            checkpointChain,
+				ListenerName:     checkpointListenerName,
+				LastScannedBlock: tracked.number,
+				LastScannedHash:  tracked.hash,
+			})
+			if li.metrics != nil {
+				li.metrics.BeefyLastProcessedBlock.WithLabelValues(checkpointChain).Set(float64(tracked.number))
+			}
+			return
+		}
+	}
+}
+
+// recordBlock appends the observed header and any event transactions found in
+// it to the rolling ring buffer, trimming entries once the buffer grows
+// beyond twice the confirmation window we need to cover.
+func (li *BeefyEthereumListener) recordBlock(header *gethTypes.Header, txs []common.Hash) {
+	li.recentBlocks = append(li.recentBlocks, trackedBlock{
+		number: header.Number.Uint64(),
+		hash:   header.Hash(),
+		parent: header.ParentHash,
+		txs:    txs,
+	})
+
+	maxBuffered := int(li.descendantsUntilFinal)*2 + 1
+	if maxBuffered < 64 {
+		maxBuffered = 64
+	}
+	if len(li.recentBlocks) > maxBuffered {
+		li.recentBlocks = li.recentBlocks[len(li.recentBlocks)-maxBuffered:]
+	}
+}
+
+// reconcileReorg walks the buffered chain backwards, re-fetching the
+// canonical header at each buffered height, and rolls back any buffered
+// blocks whose hash no longer matches the canonical chain. Status transitions
+// for events in a new head are applied as soon as that head is seen, before it
+// is descendantsUntilFinal deep; rollbackBlock reverts those made on a block
+// that has since been reorged out, and the historical processors then rerun
+// over the abandoned range on whichever chain is now canonical.
+func (li *BeefyEthereumListener) reconcileReorg(ctx context.Context, newHead *gethTypes.Header, descendantsUntilFinal uint64) error {
+	li.descendantsUntilFinal = descendantsUntilFinal
+
+	if len(li.recentBlocks) == 0 {
+		return nil
+	}
+
+	divergedAt := -1
+	for i := len(li.recentBlocks) - 1; i >= 0; i-- {
+		tracked := li.recentBlocks[i]
+		canonicalHeader, err := li.ethereumConn.GetClient().HeaderByNumber(ctx, big.NewInt(int64(tracked.number)))
+		if err != nil {
+			return fmt.Errorf("fetch canonical header at block %d: %w", tracked.number, err)
+		}
+		if canonicalHeader.Hash() == tracked.hash {
+			break
+		}
+		divergedAt = i
+	}
+
+	if divergedAt == -1 {
+		return nil
+	}
+
+	li.log.WithFields(logrus.Fields{
+		"fromBlock": li.recentBlocks[divergedAt].number,
+		"newHead":   newHead.Number.Uint64(),
+	}).Warn("Detected Ethereum reorg, rolling back affected BEEFY justification items")
+
+	abandoned := li.recentBlocks[divergedAt:]
+	li.recentBlocks = li.recentBlocks[:divergedAt]
+
+	for _, tracked := range abandoned {
+		li.rollbackBlock(tracked)
+	}
+
+	// Re-run the historical processors over the abandoned range plus
+	// whatever has been mined since, so items are matched against whichever
+	// chain ends up canonical.
+	latestBlockNumber, err := li.ethereumConn.GetClient().BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	li.processHistoricalInitialVerificationSuccessfulEvents(ctx, abandoned[0].number, latestBlockNumber)
+	li.processHistoricalFinalVerificationSuccessfulEvents(ctx, abandoned[0].number, latestBlockNumber)
+
+	return nil
+}
+
+// rollbackBlock reverts any database item that was advanced on the strength
+// of an event transaction mined in a block that has since been reorged out.
+func (li *BeefyEthereumListener) rollbackBlock(tracked trackedBlock) {
+	for _, txHash := range tracked.txs {
+		if item := li.beefyDB.GetItemByInitialVerificationTxHash(txHash); item.Status == store.InitialVerificationTxConfirmed {
+			li.log.WithField("txHash", txHash.Hex()).Warn("Rolling back item from 'InitialVerificationTxConfirmed' to 'CommitmentWitnessed'")
+			instructions := store.RollbackInstructions{RevertToStatus: store.CommitmentWitnessed}
+			li.dbMessages <- store.NewDatabaseCmd(item, store.Rollback, instructions)
+			continue
+		}
+
+		if item := li.beefyDB.GetItemByFinalVerificationTxHash(txHash); item.Status == store.CompleteVerificationTxSent {
+			li.log.WithField("txHash", txHash.Hex()).Warn("Cancelling finalization of item deleted on an abandoned block")
+			instructions := store.RollbackInstructions{RevertToStatus: store.InitialVerificationTxConfirmed, CancelDeletion: true}
+			li.dbMessages <- store.NewDatabaseCmd(item, store.Rollback, instructions)
 		}
 	}
 }
@@ -150,70 +433,98 @@ func (li *BeefyEthereumListener) queryInitialVerificationSuccessfulEvents(ctx co
 }
 
 // processHistoricalInitialVerificationSuccessfulEvents processes historical InitialVerificationSuccessful
-// events, updating the status of matched BEEFY justifications in the database
+// events, updating the status of matched BEEFY justifications in the database. Events are fetched in
+// windows (see scanLogsInWindows) and matched against the database as each window arrives, rather than
+// waiting for the entire historical range to be fetched up front.
 func (li *BeefyEthereumListener) processHistoricalInitialVerificationSuccessfulEvents(ctx context.Context,
 	blockNumber, latestBlockNumber uint64) {
 
-	// Query previous InitialVerificationSuccessful events and update the status of BEEFY justifications in database
-	events, err := li.queryInitialVerificationSuccessfulEvents(ctx, blockNumber, &latestBlockNumber)
+	found := 0
+	err := scanLogsInWindows(ctx, blockNumber, latestBlockNumber,
+		li.eventScanWindow, li.eventScanMaxWindow,
+		func(ctx context.Context, start, end uint64) (int, error) {
+			endCopy := end
+			filterOps := bind.FilterOpts{Start: start, End: &endCopy, Context: ctx}
+
+			iter, err := li.lightClientBridge.FilterInitialVerificationSuccessful(&filterOps)
+			if err != nil {
+				return 0, err
+			}
+
+			count := 0
+			for iter.Next() {
+				li.matchInitialVerificationSuccessfulEvent(iter.Event)
+				count++
+			}
+			if err := iter.Error(); err != nil {
+				return count, err
+			}
+
+			found += count
+			return count, nil
+		},
+	)
 	if err != nil {
 		li.log.WithError(err).Error("Failure fetching event logs")
 	}
 
 	li.log.Info(fmt.Sprintf(
 		"Found %d InitialVerificationSuccessful events between blocks %d-%d",
-		len(events), blockNumber, latestBlockNumber),
+		found, blockNumber, latestBlockNumber),
 	)
+}
 
-	for _, event := range events {
-		// Fetch validation data from contract using event.ID
-		validationData, err := li.lightClientBridge.ContractCaller.ValidationData(nil, event.Id)
-		if err != nil {
-			li.log.WithError(err).Error(fmt.Sprintf("Error querying validation data for ID %d", event.Id))
-		}
+// matchInitialVerificationSuccessfulEvent attempts to match a single InitialVerificationSuccessful
+// event against a CommitmentWitnessed database item via the payload_hash index, advancing it to
+// InitialVerificationTxConfirmed on success.
+func (li *BeefyEthereumListener) matchInitialVerificationSuccessfulEvent(event *lightclientbridge.ContractInitialVerificationSuccessful) {
+	li.observeEventSeen("initial_verification_successful")
 
-		// Attempt to match items in database based on their payload
-		itemFoundInDatabase := false
-		items := li.beefyDB.GetItemsByStatus(store.CommitmentWitnessed)
-		for _, item := range items {
-			generatedPayload := li.simulatePayloadGeneration(*item)
-			if generatedPayload == validationData.Payload {
-				// Update existing database item
-				li.log.Info("Updating item status from 'CommitmentWitnessed' to 'InitialVerificationTxConfirmed'")
-				instructions := map[string]interface{}{
-					"status":                  store.InitialVerificationTxConfirmed,
-					"initial_verification_tx": event.Raw.TxHash.Hex(),
-					"complete_on_block":       event.Raw.BlockNumber + li.blockWaitPeriod,
-				}
-				updateCmd := store.NewDatabaseCmd(item, store.Update, instructions)
-				li.dbMessages <- updateCmd
-
-				itemFoundInDatabase = true
-				break
-			}
-		}
-		if !itemFoundInDatabase {
-			// Don't have an existing item to update, therefore we won't be able to build the completion tx
-			li.log.Error("BEEFY justification data not found in database for InitialVerificationSuccessful event. Ignoring event.")
-		}
+	// Fetch validation data from contract using event.ID
+	validationData, err := li.lightClientBridge.ContractCaller.ValidationData(nil, event.Id)
+	if err != nil {
+		li.log.WithError(err).Error(fmt.Sprintf("Error querying validation data for ID %d", event.Id))
+	}
+
+	item := li.beefyDB.GetItemByPayload(validationData.Payload)
+	if item == nil || item.Status != store.CommitmentWitnessed {
+		// Don't have an existing item to update, therefore we won't be able to build the completion tx
+		li.log.Error("BEEFY justification data not found in database for InitialVerificationSuccessful event. Ignoring event.")
+		return
+	}
+
+	li.log.Info("Updating item status from 'CommitmentWitnessed' to 'InitialVerificationTxConfirmed'")
+	instructions := map[string]interface{}{
+		"status":                  store.InitialVerificationTxConfirmed,
+		"initial_verification_tx": event.Raw.TxHash.Hex(),
+		"complete_on_block":       event.Raw.BlockNumber + li.blockWaitPeriod,
 	}
+	li.dbMessages <- store.NewDatabaseCmd(item, store.Update, instructions)
+	li.observeStatusTransition(store.CommitmentWitnessed, store.InitialVerificationTxConfirmed)
 }
 
 // processInitialVerificationSuccessfulEvents transitions matched database items from status
-// InitialVerificationTxSent to InitialVerificationTxConfirmed
+// InitialVerificationTxSent to InitialVerificationTxConfirmed. It returns the transaction
+// hashes of every event processed, so the caller can track them against reorgs.
 func (li *BeefyEthereumListener) processInitialVerificationSuccessfulEvents(ctx context.Context,
-	blockNumber uint64) {
+	blockNumber uint64) []common.Hash {
 
 	events, err := li.queryInitialVerificationSuccessfulEvents(ctx, blockNumber, &blockNumber)
 	if err != nil {
 		li.log.WithError(err).Error("Failure fetching event logs")
+		if li.metrics != nil {
+			li.metrics.BeefyRPCErrorsTotal.WithLabelValues("FilterInitialVerificationSuccessful").Inc()
+		}
 	}
 
 	if len(events) > 0 {
 		li.log.Info(fmt.Sprintf("Found %d InitialVerificationSuccessful events on block %d", len(events), blockNumber))
 	}
 
+	var txs []common.Hash
 	for _, event := range events {
+		li.observeEventSeen("initial_verification_successful")
+
 		li.log.WithFields(logrus.Fields{
 			"blockHash":   event.Raw.BlockHash.Hex(),
 			"blockNumber": event.Raw.BlockNumber,
@@ -222,6 +533,7 @@ func (li *BeefyEthereumListener) processInitialVerificationSuccessfulEvents(ctx
 
 		// Only process events emitted by transactions sent from our node
 		if event.Prover != li.ethereumConn.GetKP().CommonAddress() {
+			li.observeEventSeen("initial_verification_successful_other_prover")
 			continue
 		}
 
@@ -237,7 +549,11 @@ func (li *BeefyEthereumListener) processInitialVerificationSuccessfulEvents(ctx
 		}
 		updateCmd := store.NewDatabaseCmd(item, store.Update, instructions)
 		li.dbMessages <- updateCmd
+		li.observeStatusTransition(store.InitialVerificationTxSent, store.InitialVerificationTxConfirmed)
+		txs = append(txs, event.Raw.TxHash)
 	}
+
+	return txs
 }
 
 // queryFinalVerificationSuccessfulEvents queries ContractFinalVerificationSuccessful events from the LightClientBridge contract
@@ -271,56 +587,84 @@ func (li *BeefyEthereumListener) queryFinalVerificationSuccessfulEvents(ctx cont
 // events, updating the status of matched BEEFY justifications in the database
 func (li *BeefyEthereumListener) processHistoricalFinalVerificationSuccessfulEvents(ctx context.Context,
 	blockNumber, latestBlockNumber uint64) {
-	// Query previous FinalVerificationSuccessful events and update the status of BEEFY justifications in database
-	events, err := li.queryFinalVerificationSuccessfulEvents(ctx, blockNumber, &latestBlockNumber)
+	found := 0
+	err := scanLogsInWindows(ctx, blockNumber, latestBlockNumber,
+		li.eventScanWindow, li.eventScanMaxWindow,
+		func(ctx context.Context, start, end uint64) (int, error) {
+			endCopy := end
+			filterOps := bind.FilterOpts{Start: start, End: &endCopy, Context: ctx}
+
+			iter, err := li.lightClientBridge.FilterFinalVerificationSuccessful(&filterOps)
+			if err != nil {
+				return 0, err
+			}
+
+			count := 0
+			for iter.Next() {
+				li.matchFinalVerificationSuccessfulEvent(iter.Event)
+				count++
+			}
+			if err := iter.Error(); err != nil {
+				return count, err
+			}
+
+			found += count
+			return count, nil
+		},
+	)
 	if err != nil {
 		li.log.WithError(err).Error("Failure fetching event logs")
 	}
 	li.log.Info(fmt.Sprintf(
 		"Found %d FinalVerificationSuccessful events between blocks %d-%d",
-		len(events), blockNumber, latestBlockNumber),
+		found, blockNumber, latestBlockNumber),
 	)
+}
 
-	for _, event := range events {
-		// Fetch validation data from contract using event.ID
-		validationData, err := li.lightClientBridge.ContractCaller.ValidationData(nil, event.Id)
-		if err != nil {
-			li.log.WithError(err).Error(fmt.Sprintf("Error querying validation data for ID %d", event.Id))
-		}
+// matchFinalVerificationSuccessfulEvent attempts to match a single FinalVerificationSuccessful
+// event against an InitialVerificationTxConfirmed database item via the payload_hash index,
+// deleting it on success.
+func (li *BeefyEthereumListener) matchFinalVerificationSuccessfulEvent(event *lightclientbridge.ContractFinalVerificationSuccessful) {
+	li.observeEventSeen("final_verification_successful")
 
-		// Attempt to match items in database based on their payload
-		itemFoundInDatabase := false
-		items := li.beefyDB.GetItemsByStatus(store.InitialVerificationTxConfirmed) // TODO: list of statuses
-		for _, item := range items {
-			generatedPayload := li.simulatePayloadGeneration(*item)
-			if generatedPayload == validationData.Payload {
-				li.log.Info("Deleting finalized item from the database'")
-				deleteCmd := store.NewDatabaseCmd(item, store.Delete, nil)
-				li.dbMessages <- deleteCmd
-
-				itemFoundInDatabase = true
-				break
-			}
-		}
-		if !itemFoundInDatabase {
-			li.log.Error("BEEFY justification data not found in database for FinalVerificationSuccessful event. Ignoring event.")
-		}
+	// Fetch validation data from contract using event.ID
+	validationData, err := li.lightClientBridge.ContractCaller.ValidationData(nil, event.Id)
+	if err != nil {
+		li.log.WithError(err).Error(fmt.Sprintf("Error querying validation data for ID %d", event.Id))
+	}
+
+	item := li.beefyDB.GetItemByPayload(validationData.Payload)
+	if item == nil || item.Status != store.InitialVerificationTxConfirmed { // TODO: list of statuses
+		li.log.Error("BEEFY justification data not found in database for FinalVerificationSuccessful event. Ignoring event.")
+		return
 	}
+
+	li.log.Info("Deleting finalized item from the database'")
+	li.dbMessages <- store.NewDatabaseCmd(item, store.Delete, nil)
+	li.observeStatusRemoved(store.InitialVerificationTxConfirmed)
 }
 
-// processFinalVerificationSuccessfulEvents removes finalized commitments from the relayer's BEEFY justification database
+// processFinalVerificationSuccessfulEvents removes finalized commitments from the relayer's
+// BEEFY justification database. It returns the transaction hashes of every event processed, so
+// the caller can track them against reorgs.
 func (li *BeefyEthereumListener) processFinalVerificationSuccessfulEvents(ctx context.Context,
-	blockNumber uint64) {
+	blockNumber uint64) []common.Hash {
 	events, err := li.queryFinalVerificationSuccessfulEvents(ctx, blockNumber, &blockNumber)
 	if err != nil {
 		li.log.WithError(err).Error("Failure fetching event logs")
+		if li.metrics != nil {
+			li.metrics.BeefyRPCErrorsTotal.WithLabelValues("FilterFinalVerificationSuccessful").Inc()
+		}
 	}
 
 	if len(events) > 0 {
 		li.log.Info(fmt.Sprintf("Found %d FinalVerificationSuccessful events on block %d", len(events), blockNumber))
 	}
 
+	var txs []common.Hash
 	for _, event := range events {
+		li.observeEventSeen("final_verification_successful")
+
 		li.log.WithFields(logrus.Fields{
 			"blockHash":   event.Raw.BlockHash.Hex(),
 			"blockNumber": event.Raw.BlockNumber,
@@ -328,6 +672,7 @@ func (li *BeefyEthereumListener) processFinalVerificationSuccessfulEvents(ctx co
 		}).Info("event information")
 
 		if event.Prover != li.ethereumConn.GetKP().CommonAddress() {
+			li.observeEventSeen("final_verification_successful_other_prover")
 			continue
 		}
 
@@ -339,11 +684,20 @@ func (li *BeefyEthereumListener) processFinalVerificationSuccessfulEvents(ctx co
 		li.log.Info("6: Deleting finalized item from the database'")
 		deleteCmd := store.NewDatabaseCmd(item, store.Delete, nil)
 		li.dbMessages <- deleteCmd
+		li.observeStatusRemoved(store.CompleteVerificationTxSent)
+		txs = append(txs, event.Raw.TxHash)
 	}
+
+	return txs
 }
 
 // matchGeneratedPayload simulates msg building and payload generation
 func (li *BeefyEthereumListener) simulatePayloadGeneration(item store.BeefyRelayInfo) [32]byte {
+	if li.metrics != nil {
+		timer := prometheus.NewTimer(li.metrics.SimulatePayloadGenerationTime)
+		defer timer.ObserveDuration()
+	}
+
 	beefyJustification, err := item.ToBeefyJustification()
 	if err != nil {
 		li.log.WithError(fmt.Errorf("Error converting BeefyRelayInfo to BeefyJustification: %s", err.Error()))
@@ -357,10 +711,69 @@ func (li *BeefyEthereumListener) simulatePayloadGeneration(item store.BeefyRelay
 	return msg.Payload
 }
 
+// observeEventSeen records that an event of the given kind was witnessed, if metrics are
+// configured. It's a no-op otherwise so instrumentation never needs nil checks at call sites.
+func (li *BeefyEthereumListener) observeEventSeen(kind string) {
+	if li.metrics == nil {
+		return
+	}
+	li.metrics.BeefyEventsSeenTotal.WithLabelValues(kind).Inc()
+}
+
+// observeStatusTransition updates the BeefyItemsInStatus gauge to reflect an item moving from
+// one status to another, if metrics are configured.
+func (li *BeefyEthereumListener) observeStatusTransition(from, to store.Status) {
+	if li.metrics == nil {
+		return
+	}
+	li.metrics.BeefyItemsInStatus.WithLabelValues(fmt.Sprintf("%d", from)).Dec()
+	li.metrics.BeefyItemsInStatus.WithLabelValues(fmt.Sprintf("%d", to)).Inc()
+}
+
+// observeStatusRemoved updates the BeefyItemsInStatus gauge to reflect an item being deleted out
+// of status from (e.g. once its commitment is finalized), if metrics are configured.
+func (li *BeefyEthereumListener) observeStatusRemoved(from store.Status) {
+	if li.metrics == nil {
+		return
+	}
+	li.metrics.BeefyItemsInStatus.WithLabelValues(fmt.Sprintf("%d", from)).Dec()
+}
+
+// backfillPayloadHashes populates payload_hash for any CommitmentWitnessed item still missing one
+// before historical events are matched against it. Items are meant to get their payload_hash at
+// insert time, but this covers rows written by a prior run before that column existed, so the
+// very first pollHistoricEventsAndHeaders after an upgrade doesn't silently drop them via a failed
+// GetItemByPayload lookup.
+func (li *BeefyEthereumListener) backfillPayloadHashes() {
+	for _, item := range li.beefyDB.GetItemsByStatus(store.CommitmentWitnessed) {
+		li.payloadHashFor(item)
+	}
+}
+
+// payloadHashFor returns item.PayloadHash if it was already computed and persisted at insert
+// time. Otherwise it falls back to simulatePayloadGeneration and persists the result, so live-path
+// callers on the hot new-head loop (e.g. forwardWitnessedBeefyCommitment) never recompute the
+// same payload hash for an item that's already been through the pipeline once.
+func (li *BeefyEthereumListener) payloadHashFor(item *store.BeefyRelayInfo) [32]byte {
+	var empty [32]byte
+	if item.PayloadHash != empty {
+		return item.PayloadHash
+	}
+
+	payloadHash := li.simulatePayloadGeneration(*item)
+	li.dbMessages <- store.NewDatabaseCmd(item, store.Update, map[string]interface{}{
+		"payload_hash": payloadHash,
+	})
+	return payloadHash
+}
+
 // forwardWitnessedBeefyCommitment forwards witnessed BEEFY commitments to the Ethereum writer
 func (li *BeefyEthereumListener) forwardWitnessedBeefyCommitment(ctx context.Context, blockNumber, descendantsUntilFinal uint64) {
 	witnessedItems := li.beefyDB.GetItemsByStatus(store.CommitmentWitnessed)
 	for _, item := range witnessedItems {
+		// Ensure payload_hash is populated so the InitialVerificationSuccessful event for this
+		// item can be matched via the index instead of a full status-bucket scan.
+		li.payloadHashFor(item)
 		li.beefyMessages <- *item
 	}
 
@@ -0,0 +1,86 @@
+package beefyrelayer
+
+import (
+	"context"
+	"strings"
+)
+
+// defaultScanWindow is the number of blocks covered by a single eth_getLogs call when no
+// window size is configured. It's conservative enough to work against most hosted RPC
+// endpoints (Infura/Alchemy/Erigon) without tripping their result-size limits.
+const defaultScanWindow = 5000
+
+// filterWindowFunc queries logs in the inclusive block range [start, end] and forwards any
+// matches found to the caller, returning the number of matches made.
+type filterWindowFunc func(ctx context.Context, start, end uint64) (int, error)
+
+// scanLogsInWindows walks [start, end] in windows of up to maxWindow blocks (initialWindow to
+// begin with), calling filter for each window. If filter reports that the RPC endpoint
+// rejected the range as too large, the window is halved and the same range retried; on
+// success the window is grown back towards maxWindow so a long backlog doesn't pay the
+// smallest window's round-trip cost for its entire length.
+func scanLogsInWindows(ctx context.Context, start, end uint64, initialWindow, maxWindow uint64, filter filterWindowFunc) error {
+	if initialWindow == 0 {
+		initialWindow = defaultScanWindow
+	}
+	if maxWindow == 0 || maxWindow < initialWindow {
+		maxWindow = initialWindow
+	}
+
+	window := initialWindow
+	cursor := start
+	for cursor <= end {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		windowEnd := cursor + window - 1
+		if windowEnd > end {
+			windowEnd = end
+		}
+
+		_, err := filter(ctx, cursor, windowEnd)
+		if err != nil {
+			if isRangeTooLargeError(err) && window > 1 {
+				window /= 2
+				continue
+			}
+			return err
+		}
+
+		cursor = windowEnd + 1
+		if window < maxWindow {
+			window *= 2
+			if window > maxWindow {
+				window = maxWindow
+			}
+		}
+	}
+
+	return nil
+}
+
+// isRangeTooLargeError reports whether err looks like one of the "query returned more than N
+// results"/"-32005" style errors that hosted Ethereum RPC providers return when a log filter
+// range covers too many blocks.
+func isRangeTooLargeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	needles := []string{
+		"-32005",
+		"query returned more than",
+		"limit exceeded",
+		"too many results",
+		"block range is too wide",
+	}
+	for _, needle := range needles {
+		if strings.Contains(msg, needle) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package store
+
+import "github.com/ethereum/go-ethereum/common"
+
+// Checkpoint records how far a listener has scanned a chain, keyed by chain
+// and listener name, so that a restart can resume from where it left off
+// instead of rescanning from the configured start block.
+type Checkpoint struct {
+	Chain            string
+	ListenerName     string
+	LastScannedBlock uint64
+	LastScannedHash  common.Hash
+}
+
+// NewCheckpointCmd builds a DatabaseCmd that writes a Checkpoint through the
+// same single-writer channel used for BeefyRelayInfo mutations, so listeners
+// never touch the underlying checkpoint bucket directly.
+func NewCheckpointCmd(checkpoint Checkpoint) DatabaseCmd {
+	return NewDatabaseCmd(checkpoint, Update, nil)
+}
@@ -0,0 +1,18 @@
+package store
+
+// Rollback reverts a status transition that was previously applied to a
+// BeefyRelayInfo item. It is used when BeefyEthereumListener detects that the
+// Ethereum block a transition depended on (e.g. InitialVerificationTxConfirmed)
+// is no longer part of the canonical chain after a reorg.
+const Rollback = CmdType(2)
+
+// RollbackInstructions describes how to revert a single database item during
+// a rollback. RevertToStatus is the status the item held before the
+// transition being undone; CancelDeletion is set when the item had already
+// been queued for deletion (e.g. on observing a FinalVerificationSuccessful
+// event) and that deletion must be cancelled because the finalizing block
+// was reorged out.
+type RollbackInstructions struct {
+	RevertToStatus Status
+	CancelDeletion bool
+}
@@ -2,13 +2,11 @@ package parachaincommitmentrelayer
 
 import (
 	"context"
-	"encoding/hex"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/crypto/blake2b"
 	"github.com/sirupsen/logrus"
-	rpcOffchain "github.com/snowfork/go-substrate-rpc-client/v2/rpc/offchain"
 	"github.com/snowfork/go-substrate-rpc-client/v2/types"
 	"github.com/wealdtech/go-merkletree"
 	"golang.org/x/sync/errgroup"
@@ -19,46 +17,114 @@ import (
 	"github.com/snowfork/polkadot-ethereum/relayer/workers/beefyrelayer/store"
 )
 
-//TODO - put in config
-const OUR_PARACHAIN_ID = 200
-
-// TODO: This file is currently listening to the relay chain for new beefy justifications. This is temporary, as in
-// a follow up PR, it will be changed to listen to Ethereum for new justifications.
-// This can't be done yet, as we still need to add block numbers to the Ethereum proofs being submitted
-// to the relay chain light client, but will be done once that's complete.
+// BeefyListener's stream of justified commitments comes from a Source (see beefy-source.go):
+// by default RelayChainBeefySource, which listens to the relay chain's own beefy_subscribeJustifications
+// RPC, or EthereumBeefySource, which instead trusts only commitments Ethereum's light client
+// contract has itself accepted. subBeefyJustifications doesn't assume justifications arrive for
+// every leaf: on each one it walks every unprocessed leaf up to the justified block (see
+// lastProcessedLeaf), so commitments aren't skipped if justifications are sparse or a leaf was
+// missed entirely.
 
 type MessagePackage struct {
-	channelID              chainTypes.ChannelID
-	commitmentHash         types.H256
-	commitmentMessagesData types.StorageDataRaw
-	paraHeadProof          [][32]byte
-	mmrProof               types.GenerateMMRProofResponse
+	channelID               chainTypes.ChannelID
+	commitmentHash          types.H256
+	commitmentMessagesData  types.StorageDataRaw
+	commitmentMessagesProof types.ReadProof
+	paraHeadProof           [][32]byte
+	mmrProof                types.GenerateMMRProofResponse
 }
 
+// beefyListenerCheckpointChain and beefyListenerCheckpointName identify this listener's row in
+// the store.Checkpoint table, tracking the highest BEEFY MMR leaf (i.e. relay chain block
+// number) whose parachain commitments have already been extracted and emitted, so a restart -
+// or a BEEFY justification arriving many blocks after the previous one - never skips over
+// commitments that were buried in intermediate, unjustified leaves.
+const (
+	beefyListenerCheckpointChain = "relaychain"
+	beefyListenerCheckpointName  = "parachaincommitment-beefy-listener"
+)
+
 type BeefyListener struct {
-	relaychainConfig    *relaychain.Config
-	relaychainConn      *relaychain.Connection
-	parachainConnection *parachain.Connection
-	messages            chan<- MessagePackage
-	log                 *logrus.Entry
+	config               *Config
+	relaychainConfig     *relaychain.Config
+	relaychainConn       *relaychain.Connection
+	parachainConnections map[uint32]*parachain.Connection
+	messages             chan<- MessagePackage
+	checkpointDB         *store.Database
+	dbMessages           chan<- store.DatabaseCmd
+	source               Source
+	log                  *logrus.Entry
 }
 
+// NewBeefyListener constructs a listener that fans commitments out to every parachain listed in
+// config, reading each one's on-chain commitment storage through its entry in
+// parachainConnections (keyed by ParachainID).
 func NewBeefyListener(
+	config *Config,
 	relaychainConfig *relaychain.Config,
 	relaychainConn *relaychain.Connection,
-	parachainConnection *parachain.Connection,
+	parachainConnections map[uint32]*parachain.Connection,
 	messages chan<- MessagePackage,
 	log *logrus.Entry) *BeefyListener {
 	return &BeefyListener{
-		relaychainConfig:    relaychainConfig,
-		relaychainConn:      relaychainConn,
-		parachainConnection: parachainConnection,
-		messages:            messages,
-		log:                 log,
+		config:               config,
+		relaychainConfig:     relaychainConfig,
+		relaychainConn:       relaychainConn,
+		parachainConnections: parachainConnections,
+		messages:             messages,
+		log:                  log,
 	}
 }
 
+// SetCheckpointDB attaches the store backing last-processed-leaf persistence. Leaving it unset
+// makes subBeefyJustifications fall back to processing only the latest justified leaf, matching
+// its previous behaviour.
+func (li *BeefyListener) SetCheckpointDB(checkpointDB *store.Database, dbMessages chan<- store.DatabaseCmd) {
+	li.checkpointDB = checkpointDB
+	li.dbMessages = dbMessages
+}
+
+// parachainIDs returns the configured parachain IDs this listener fans commitments out to, in
+// config order, so one relayer process can serve several parachains sharing the same relay chain.
+func (li *BeefyListener) parachainIDs() []uint32 {
+	if li.config == nil {
+		return nil
+	}
+	ids := make([]uint32, len(li.config.Parachains))
+	for i, p := range li.config.Parachains {
+		ids[i] = p.ParachainID
+	}
+	return ids
+}
+
+// SetSource overrides where BeefyListener gets its stream of justified BEEFY commitments from.
+// Leaving it unset defaults Start to a RelayChainBeefySource, matching the listener's previous,
+// relay-chain-RPC-only behaviour.
+func (li *BeefyListener) SetSource(source Source) {
+	li.source = source
+}
+
+// lastProcessedLeaf returns the highest MMR leaf (relay chain block number) already processed,
+// or latestLeaf-1 if no checkpoint exists yet, so a fresh database only processes the newly
+// justified leaf rather than replaying the chain's entire history.
+func (li *BeefyListener) lastProcessedLeaf(latestLeaf uint64) uint64 {
+	if latestLeaf == 0 {
+		return 0
+	}
+	if li.checkpointDB == nil {
+		return latestLeaf - 1
+	}
+	checkpoint, ok := li.checkpointDB.GetCheckpoint(beefyListenerCheckpointChain, beefyListenerCheckpointName)
+	if !ok {
+		return latestLeaf - 1
+	}
+	return checkpoint.LastScannedBlock
+}
+
 func (li *BeefyListener) Start(ctx context.Context, eg *errgroup.Group) error {
+	if li.source == nil {
+		li.source = NewRelayChainBeefySource(li.relaychainConn, li.log)
+	}
 
 	eg.Go(func() error {
 		return li.subBeefyJustifications(ctx)
@@ -75,88 +141,118 @@ func (li *BeefyListener) onDone(ctx context.Context) error {
 	return ctx.Err()
 }
 
+// subBeefyJustifications processes every justification li.source reports, regardless of whether
+// it came from the relay chain's own RPC or from Ethereum's light client contract.
 func (li *BeefyListener) subBeefyJustifications(ctx context.Context) error {
-	ch := make(chan interface{})
-
-	li.log.Info("Subscribing to relay chain light client for new mmr payloads")
-	sub, err := li.relaychainConn.GetAPI().Client.Subscribe(context.Background(), "beefy", "subscribeJustifications", "unsubscribeJustifications", "justifications", ch)
+	justifications, err := li.source.Subscribe(ctx)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	defer sub.Unsubscribe()
 
 	for {
 		select {
 		case <-ctx.Done():
 			return li.onDone(ctx)
-		case msg := <-ch:
-
-			signedCommitment := &store.SignedCommitment{}
-			err := types.DecodeFromHexString(msg.(string), signedCommitment)
-			if err != nil {
-				li.log.WithError(err).Error("Failed to decode BEEFY commitment messages")
+		case justification, ok := <-justifications:
+			if !ok {
+				return li.onDone(ctx)
 			}
+			li.processJustification(justification.BlockNumber)
+		}
+	}
+}
 
-			blockNumber := signedCommitment.Commitment.BlockNumber
+// processJustification walks every MMR leaf between the last one we emitted commitments for and
+// the leaf the justification covers, so a justification that arrives many blocks after the
+// previous one never causes intermediate commitments to be skipped.
+func (li *BeefyListener) processJustification(blockNumber uint64) {
+	li.log.WithField("blockNumber", blockNumber+1).Info("Getting hash for next block")
+	nextBlockHash, err := li.relaychainConn.GetAPI().RPC.Chain.GetBlockHash(blockNumber + 1)
+	if err != nil {
+		li.log.WithError(err).Error("Failed to get block hash")
+		return
+	}
+	li.log.WithField("blockHash", nextBlockHash.Hex()).Info("Got blockhash")
 
-			li.log.WithFields(logrus.Fields{
-				"commitmentBlockNumber": blockNumber,
-				"payload":               signedCommitment.Commitment.Payload.Hex(),
-				"validatorSetID":        signedCommitment.Commitment.ValidatorSetID,
-			}).Info("Witnessed a new BEEFY commitment:")
-			if len(signedCommitment.Signatures) == 0 {
-				li.log.Info("BEEFY commitment has no signatures, skipping...")
-				continue
-			} else {
-				hash := blake2b.Sum256(signedCommitment.Commitment.Bytes())
-				li.log.WithFields(logrus.Fields{
-					"commitment":       hex.EncodeToString(signedCommitment.Commitment.Bytes()),
-					"hashedCommitment": hex.EncodeToString(hash[:]),
-				}).Info("Commitment with signatures:")
-			}
-			li.log.WithField("blockNumber", blockNumber+1).Info("Getting hash for next block")
-			nextBlockHash, err := li.relaychainConn.GetAPI().RPC.Chain.GetBlockHash(uint64(blockNumber + 1))
+	firstUnprocessedLeaf := li.lastProcessedLeaf(blockNumber) + 1
+	for leafIndex := firstUnprocessedLeaf; leafIndex <= blockNumber; leafIndex++ {
+		leafBlockHash, err := li.relaychainConn.GetAPI().RPC.Chain.GetBlockHash(leafIndex)
+		if err != nil {
+			li.log.WithError(err).Error("Failed to get block hash for leaf")
+			continue
+		}
+
+		mmrProof := li.GetMMRLeafForBlock(leafIndex, nextBlockHash)
+		allParaHeads, err := li.relaychainConn.GetAllParaHeads(leafBlockHash)
+		if err != nil {
+			li.log.WithError(err).Error("Failed to get all parachain heads")
+			continue
+		}
+
+		leafSucceeded := true
+		for _, parachainID := range li.parachainIDs() {
+			paraHead, err := findOurParaHead(allParaHeads, parachainID)
 			if err != nil {
-				li.log.WithError(err).Error("Failed to get block hash")
+				li.log.WithError(err).WithField("parachainID", parachainID).Error("Failed to find parachain head")
+				leafSucceeded = false
+				continue
 			}
-			li.log.WithField("blockHash", nextBlockHash.Hex()).Info("Got blockhash")
-
-			// TODO this just queries the latest MMR leaf in the latest MMR and our latest parahead in that leaf.
-			// we should ideally be querying the last few leaves in the latest MMR until we find
-			// the first parachain block that has not yet been fully processed on ethereum,
-			// and then package and relay all newer heads/commitments
-			mmrProof := li.GetMMRLeafForBlock(uint64(blockNumber), nextBlockHash)
-			allParaHeads, ourParaHead := li.GetAllParaheads(nextBlockHash, OUR_PARACHAIN_ID)
 
-			ourParaHeadProof, err := createParachainHeaderProof(allParaHeads, ourParaHead)
+			paraHeadProof, err := createParachainHeaderProof(allParaHeads, paraHead)
 			if err != nil {
-				li.log.WithError(err).Error("Failed to create para head proof")
+				li.log.WithError(err).WithField("parachainID", parachainID).Error("Failed to create para head proof")
+				leafSucceeded = false
+				continue
 			}
 
 			li.log.WithFields(logrus.Fields{
+				"leafIndex":      leafIndex,
+				"parachainID":    parachainID,
 				"ParachainHeads": mmrProof.Leaf.ParachainHeads.Hex(),
-			}).Info("ParachainHeadsParachainHeadsParachainHeads")
+			}).Info("Processing parachain head for leaf")
 
-			messagePackets, err := li.extractCommitments(ourParaHead, mmrProof, ourParaHeadProof)
+			messagePackets, err := li.extractCommitments(parachainID, paraHead.DecodedHeader, mmrProof, paraHeadProof)
 			if err != nil {
-				li.log.WithError(err).Error("Failed to extract commitment and messages")
+				li.log.WithError(err).WithField("parachainID", parachainID).Error("Failed to extract commitment and messages")
+				leafSucceeded = false
+				continue
 			}
 			if len(messagePackets) == 0 {
 				li.log.Info("Parachain header has no commitment with messages, skipping...")
-				continue
 			}
 			for _, messagePacket := range messagePackets {
 				li.log.WithFields(logrus.Fields{
-					"channelID":              messagePacket.channelID,
-					"commitmentHash":         messagePacket.commitmentHash,
-					"commitmentMessagesData": messagePacket.commitmentMessagesData,
-					"ourParaHeadProof":       messagePacket.paraHeadProof,
-					"mmrProof":               messagePacket.mmrProof,
+					"parachainID":             parachainID,
+					"channelID":               messagePacket.channelID,
+					"commitmentHash":          messagePacket.commitmentHash,
+					"commitmentMessagesData":  messagePacket.commitmentMessagesData,
+					"commitmentMessagesProof": messagePacket.commitmentMessagesProof,
+					"ourParaHeadProof":        messagePacket.paraHeadProof,
+					"mmrProof":                messagePacket.mmrProof,
 				}).Info("Beefy Listener emitted new message packet")
 
 				li.messages <- messagePacket
 			}
 		}
+
+		// If any parachain failed to process this leaf, don't advance the checkpoint past it -
+		// doing so would mark the leaf (and that parachain's commitment within it) as done, and
+		// lastProcessedLeaf would never revisit it. Stop here too: checkpointing a later leaf
+		// would have the same effect for this one, since only the single furthest LastScannedBlock
+		// is tracked.
+		if !leafSucceeded {
+			li.log.WithField("leafIndex", leafIndex).Warn("Not advancing checkpoint: at least one parachain failed to process this leaf, will retry next justification")
+			return
+		}
+
+		if li.dbMessages != nil {
+			li.dbMessages <- store.NewCheckpointCmd(store.Checkpoint{
+				Chain:            beefyListenerCheckpointChain,
+				ListenerName:     beefyListenerCheckpointName,
+				LastScannedBlock: leafIndex,
+				LastScannedHash:  common.BytesToHash(leafBlockHash[:]),
+			})
+		}
 	}
 }
 
@@ -193,121 +289,45 @@ func (li *BeefyListener) GetMMRLeafForBlock(
 	return proofResponse
 }
 
-func (li *BeefyListener) GetAllParaheads(blockHash types.Hash, ourParachainId uint32) ([]types.Header, types.Header) {
-	none := types.NewOptionU32Empty()
-	encoded, err := types.EncodeToBytes(none)
-	if err != nil {
-		li.log.WithError(err).Error("Error")
-	}
-
-	baseParaHeadsStorageKey, err := types.CreateStorageKey(
-		li.relaychainConn.GetMetadata(),
-		"Paras",
-		"Heads", encoded, nil)
-	if err != nil {
-		li.log.WithError(err).Error("Failed to create parachain header storage key")
-	}
-
-	//TODO fix this manual slice.
-	// The above types.CreateStorageKey does not give the same base key as polkadotjs needs for getKeys.
-	// It has some extra bytes.
-	// maybe from the none u32 in golang being wrong, or maybe slightly off CreateStorageKey call? we slice it
-	// here as a hack.
-	actualBaseParaHeadsStorageKey := baseParaHeadsStorageKey[:32]
-	li.log.WithField("actualBaseParaHeadsStorageKey", actualBaseParaHeadsStorageKey.Hex()).Info("actualBaseParaHeadsStorageKey")
-
-	keysResponse, err := li.relaychainConn.GetAPI().RPC.State.GetKeys(actualBaseParaHeadsStorageKey, blockHash)
-	if err != nil {
-		li.log.WithError(err).Error("Failed to get all parachain keys")
-	}
-
-	headersResponse, err := li.relaychainConn.GetAPI().RPC.State.QueryStorage(keysResponse, blockHash, blockHash)
-	if err != nil {
-		li.log.WithError(err).Error("Failed to get all parachain headers")
-	}
-
-	li.log.Info("Got all parachain headers")
-	var headers []types.Header
-	var ourParachainHeader types.Header
-	for _, headerResponse := range headersResponse {
-		for _, change := range headerResponse.Changes {
-
-			// TODO fix this manual slice with a proper type decode. only the last few bytes are for the ParaId,
-			// not sure what the early ones are for.
-			key := change.StorageKey[40:]
-			var parachainID types.U32
-			if err := types.DecodeFromBytes(key, &parachainID); err != nil {
-				li.log.WithError(err).Error("Failed to decode parachain ID")
-			}
-
-			li.log.WithField("parachainId", parachainID).Info("Decoding header for parachain")
-			var encodableOpaqueHeader types.Bytes
-			if err := types.DecodeFromBytes(change.StorageData, &encodableOpaqueHeader); err != nil {
-				li.log.WithError(err).Error("Failed to decode MMREncodableOpaqueLeaf")
-			}
-
-			var header types.Header
-			if err := types.DecodeFromBytes(encodableOpaqueHeader, &header); err != nil {
-				li.log.WithError(err).Error("Failed to decode Header")
-			}
-			li.log.WithFields(logrus.Fields{
-				"headerBytes":           fmt.Sprintf("%#x", encodableOpaqueHeader),
-				"header.ParentHash":     header.ParentHash.Hex(),
-				"header.Number":         header.Number,
-				"header.StateRoot":      header.StateRoot.Hex(),
-				"header.ExtrinsicsRoot": header.ExtrinsicsRoot.Hex(),
-				"header.Digest":         header.Digest,
-				"parachainId":           parachainID,
-			}).Info("Decoded header for parachain")
-			headers = append(headers, header)
-
-			if parachainID == types.U32(ourParachainId) {
-				ourParachainHeader = header
-			}
+// findOurParaHead returns the caller's own parachain head out of allParaHeads, which must come
+// from relaychain.Connection.GetAllParaHeads so it's already sorted into the ParaID order the
+// MMR leaf's Merkle root was built from.
+func findOurParaHead(allParaHeads []relaychain.ParaHead, ourParachainID uint32) (relaychain.ParaHead, error) {
+	for _, head := range allParaHeads {
+		if head.ParaID == ourParachainID {
+			return head, nil
 		}
 	}
-	return headers, ourParachainHeader
+	return relaychain.ParaHead{}, fmt.Errorf("parachain %d not found in Paras.Heads at this block", ourParachainID)
 }
 
-func createParachainHeaderProof(allParaHeads []types.Header, ourParaHead types.Header) ([][32]byte, error) {
-	var allParaHeadsBytes [][]byte
-	for _, paraHead := range allParaHeads {
-		paraHeadBytes, err := types.EncodeToBytes(paraHead)
-		if err != nil {
-			return [][32]byte{}, err
-		}
-		allParaHeadsBytes = append(allParaHeadsBytes, paraHeadBytes)
-	}
-	ourParaHeadBytes, err := types.EncodeToBytes(ourParaHead)
-	if err != nil {
-		return [][32]byte{}, err
+// createParachainHeaderProof builds a Keccak256 Merkle proof for ourParaHead's still-encoded
+// header against allParaHeads, the full ParaID-ordered list of heads the relay chain hashed into
+// MMRLeaf.ParachainHeads. Proving against the raw encoded bytes read from storage - rather than
+// re-encoding the decoded header - guarantees the leaf matches what was actually hashed on chain.
+func createParachainHeaderProof(allParaHeads []relaychain.ParaHead, ourParaHead relaychain.ParaHead) ([][32]byte, error) {
+	paraTreeData := make([][]byte, len(allParaHeads))
+	for i, paraHead := range allParaHeads {
+		paraTreeData[i] = paraHead.EncodedHeader
 	}
 
-	paraTreeData := make([][]byte, len(allParaHeadsBytes))
-	for i, paraHead := range allParaHeadsBytes {
-		paraTreeData[i] = paraHead
-	}
-
-	// Create the tree
 	paraMerkleTree, err := merkletree.NewUsing(paraTreeData, &Keccak256{}, nil)
 	if err != nil {
-		return [][32]byte{}, err
+		return nil, err
 	}
 
-	// Generate Merkle Proof for our parachain's head
-	proof, err := paraMerkleTree.GenerateProof(ourParaHeadBytes)
+	proof, err := paraMerkleTree.GenerateProof(ourParaHead.EncodedHeader)
 	if err != nil {
-		return [][32]byte{}, err
+		return nil, err
 	}
 
-	// Verify the proof
 	root := paraMerkleTree.Root()
-	verified, err := merkletree.VerifyProofUsing(ourParaHeadBytes, proof, root, &Keccak256{}, nil)
+	verified, err := merkletree.VerifyProofUsing(ourParaHead.EncodedHeader, proof, root, &Keccak256{}, nil)
 	if err != nil {
-		return [][32]byte{}, err
+		return nil, err
 	}
 	if !verified {
-		return [][32]byte{}, fmt.Errorf("failed to verify proof")
+		return nil, fmt.Errorf("failed to verify parachain head proof")
 	}
 
 	proofContents := make([][32]byte, len(proof.Hashes))
@@ -317,27 +337,6 @@ func createParachainHeaderProof(allParaHeads []types.Header, ourParaHead types.H
 		proofContents[i] = hash32Byte
 	}
 
-	fmt.Println("parachain-commitment-relayer allParaHeadsBytes", allParaHeadsBytes)
-	allParaHeadsBytesHex, _ := types.EncodeToHexString(allParaHeadsBytes)
-	fmt.Println("parachain-commitment-relayer allParaHeadsBytesHex", allParaHeadsBytesHex)
-
-	paraHeadBytes0Hex, _ := types.EncodeToHexString(allParaHeadsBytes[0])
-	fmt.Println("parachain-commitment-relayer paraHeadBytes0Hex", paraHeadBytes0Hex)
-	paraHeadBytes1Hex, _ := types.EncodeToHexString(allParaHeadsBytes[1])
-	fmt.Println("parachain-commitment-relayer paraHeadBytes1Hex", paraHeadBytes1Hex)
-	fmt.Println("parachain-commitment-relayer paraHeadBytesHex", paraHeadBytes0Hex, paraHeadBytes1Hex)
-
-	fmt.Println("parachain-commitment-relayer ourParaHeadBytes", ourParaHeadBytes)
-	ourParaHeadBytesHex, _ := types.EncodeToHexString(ourParaHeadBytes)
-	fmt.Println("parachain-commitment-relayer ourParaHeadBytesHex", ourParaHeadBytesHex)
-	rootHex, _ := types.EncodeToHexString(root)
-	fmt.Println("parachain-commitment-relayer root", rootHex)
-	fmt.Println("parachain-commitment-relayer proof", proof)
-	fmt.Println("parachain-commitment-relayer len(proof.Hashes)", len(proof.Hashes))
-	fmt.Println("parachain-commitment-relayer proofContents", proofContents)
-	proofContents0Hex, _ := types.EncodeToHexString(proofContents[0])
-	fmt.Println("parachain-commitment-relayer proofContents0Hex", proofContents0Hex)
-
 	return proofContents, nil
 }
 
@@ -356,11 +355,13 @@ func (h *Keccak256) Hash(data []byte) []byte {
 }
 
 func (li *BeefyListener) extractCommitments(
+	parachainID uint32,
 	header types.Header,
 	mmrProof types.GenerateMMRProofResponse,
 	ourParaHeadProof [][32]byte) ([]MessagePackage, error) {
 
 	li.log.WithFields(logrus.Fields{
+		"parachainID": parachainID,
 		"blockNumber": header.Number,
 	}).Debug("Extracting commitment from parachain header")
 
@@ -377,7 +378,7 @@ func (li *BeefyListener) extractCommitments(
 			"commitmentHash": auxDigestItem.AsCommitment.Hash.Hex(),
 		}).Debug("Found commitment hash in header digest")
 		commitmentHash := auxDigestItem.AsCommitment.Hash
-		commitmentMessagesData, err := li.getMessagesDataForDigestItem(&auxDigestItem)
+		commitmentMessagesData, commitmentMessagesProof, err := li.getMessagesDataForDigestItem(parachainID, &auxDigestItem, header.Hash())
 		if err != nil {
 			return nil, err
 		}
@@ -385,6 +386,7 @@ func (li *BeefyListener) extractCommitments(
 			auxDigestItem.AsCommitment.ChannelID,
 			commitmentHash,
 			commitmentMessagesData,
+			commitmentMessagesProof,
 			ourParaHeadProof,
 			mmrProof,
 		}
@@ -409,26 +411,44 @@ func getAuxiliaryDigestItems(digest types.Digest) ([]chainTypes.AuxiliaryDigestI
 	return auxDigestItems, nil
 }
 
-func (li *BeefyListener) getMessagesDataForDigestItem(digestItem *chainTypes.AuxiliaryDigestItem) (types.StorageDataRaw, error) {
+// getMessagesDataForDigestItem reads a commitment's message payload from the Outbound pallet's
+// on-chain Messages storage item, keyed by channel ID and commitment hash, at the finalized
+// parachain block the MMR leaf commits to. It also fetches a storage proof for that key so the
+// Ethereum side can verify the payload against the parachain header's state root, rather than
+// trusting the relayer the way the old offchain-indexed lookup did.
+func (li *BeefyListener) getMessagesDataForDigestItem(parachainID uint32, digestItem *chainTypes.AuxiliaryDigestItem, blockHash types.Hash) (types.StorageDataRaw, types.ReadProof, error) {
+	parachainConnection, ok := li.parachainConnections[parachainID]
+	if !ok {
+		return nil, types.ReadProof{}, fmt.Errorf("no parachain connection configured for parachain %d", parachainID)
+	}
+
 	storageKey, err := parachain.MakeStorageKey(digestItem.AsCommitment.ChannelID, digestItem.AsCommitment.Hash)
 	if err != nil {
-		return nil, err
+		return nil, types.ReadProof{}, err
 	}
 
-	data, err := li.parachainConnection.GetAPI().RPC.Offchain.LocalStorageGet(rpcOffchain.Persistent, storageKey)
+	var data types.StorageDataRaw
+	ok, err = parachainConnection.GetAPI().RPC.State.GetStorage(storageKey, &data, blockHash)
 	if err != nil {
-		li.log.WithError(err).Error("Failed to read commitment from offchain storage")
-		return nil, err
+		li.log.WithError(err).Error("Failed to read commitment from on-chain storage")
+		return nil, types.ReadProof{}, err
+	}
+	if !ok {
+		err := fmt.Errorf("commitment not found in on-chain storage for key %s at block %s", storageKey.Hex(), blockHash.Hex())
+		li.log.WithError(err).Error("Commitment not found in on-chain storage")
+		return nil, types.ReadProof{}, err
 	}
 
-	if data != nil {
-		li.log.WithFields(logrus.Fields{
-			"commitmentSizeBytes": len(*data),
-		}).Debug("Retrieved commitment from offchain storage")
-	} else {
-		li.log.WithError(err).Error("Commitment not found in offchain storage")
-		return nil, err
+	proof, err := parachainConnection.GetAPI().RPC.State.GetReadProof([]string{storageKey.Hex()}, blockHash)
+	if err != nil {
+		li.log.WithError(err).Error("Failed to generate storage proof for commitment")
+		return nil, types.ReadProof{}, err
 	}
 
-	return *data, nil
+	li.log.WithFields(logrus.Fields{
+		"commitmentSizeBytes": len(data),
+		"proofItems":          len(proof.Proof),
+	}).Debug("Retrieved commitment and storage proof from on-chain storage")
+
+	return data, proof, nil
 }
@@ -0,0 +1,157 @@
+package parachaincommitmentrelayer
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/blake2b"
+	"github.com/sirupsen/logrus"
+	"github.com/snowfork/go-substrate-rpc-client/v2/types"
+
+	"github.com/snowfork/polkadot-ethereum/relayer/chain/ethereum"
+	"github.com/snowfork/polkadot-ethereum/relayer/chain/relaychain"
+	"github.com/snowfork/polkadot-ethereum/relayer/contracts/lightclientbridge"
+	"github.com/snowfork/polkadot-ethereum/relayer/workers/beefyrelayer/store"
+)
+
+// BeefyJustification is the relay chain block number a newly-accepted BEEFY commitment covers,
+// regardless of which Source reported it.
+type BeefyJustification struct {
+	BlockNumber uint64
+}
+
+// Source reports newly accepted BEEFY commitments for BeefyListener to process. RelayChainBeefySource
+// trusts the relay chain node's own view of finality; EthereumBeefySource instead trusts
+// whichever commitments the light client contract has accepted on Ethereum, which is the
+// stronger guarantee since it can't be forged by a lying relay chain RPC endpoint.
+type Source interface {
+	Subscribe(ctx context.Context) (<-chan BeefyJustification, error)
+}
+
+// RelayChainBeefySource reports a justification for every BEEFY commitment the relay chain's own
+// beefy_subscribeJustifications RPC emits.
+type RelayChainBeefySource struct {
+	relaychainConn *relaychain.Connection
+	log            *logrus.Entry
+}
+
+// NewRelayChainBeefySource constructs a Source backed by the relay chain's own BEEFY RPC.
+func NewRelayChainBeefySource(relaychainConn *relaychain.Connection, log *logrus.Entry) *RelayChainBeefySource {
+	return &RelayChainBeefySource{relaychainConn: relaychainConn, log: log}
+}
+
+func (s *RelayChainBeefySource) Subscribe(ctx context.Context) (<-chan BeefyJustification, error) {
+	rpcCh := make(chan interface{})
+	s.log.Info("Subscribing to relay chain light client for new mmr payloads")
+	sub, err := s.relaychainConn.GetAPI().Client.Subscribe(context.Background(), "beefy", "subscribeJustifications", "unsubscribeJustifications", "justifications", rpcCh)
+	if err != nil {
+		return nil, err
+	}
+
+	justifications := make(chan BeefyJustification)
+	go func() {
+		defer sub.Unsubscribe()
+		defer close(justifications)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-rpcCh:
+				signedCommitment := &store.SignedCommitment{}
+				if err := types.DecodeFromHexString(msg.(string), signedCommitment); err != nil {
+					s.log.WithError(err).Error("Failed to decode BEEFY commitment messages")
+					continue
+				}
+				if len(signedCommitment.Signatures) == 0 {
+					s.log.Info("BEEFY commitment has no signatures, skipping...")
+					continue
+				}
+				hash := blake2b.Sum256(signedCommitment.Commitment.Bytes())
+				s.log.WithFields(logrus.Fields{
+					"commitmentBlockNumber": signedCommitment.Commitment.BlockNumber,
+					"payload":               signedCommitment.Commitment.Payload.Hex(),
+					"validatorSetID":        signedCommitment.Commitment.ValidatorSetID,
+					"commitment":            hex.EncodeToString(signedCommitment.Commitment.Bytes()),
+					"hashedCommitment":      hex.EncodeToString(hash[:]),
+				}).Info("Witnessed a new BEEFY commitment")
+
+				select {
+				case justifications <- BeefyJustification{BlockNumber: uint64(signedCommitment.Commitment.BlockNumber)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return justifications, nil
+}
+
+// EthereumBeefySource reports a justification only once Ethereum's BEEFY light client contract
+// has itself accepted the corresponding MMR root, so a relayer using it never acts on a
+// commitment the relay chain RPC claims is justified but Ethereum hasn't actually finalized.
+type EthereumBeefySource struct {
+	ethereumConn      *ethereum.Connection
+	lightClientBridge *lightclientbridge.Contract
+	log               *logrus.Entry
+}
+
+// NewEthereumBeefySource constructs a Source backed by NewMMRRoot events on the given BEEFY light
+// client contract, lightClientBridge.
+func NewEthereumBeefySource(ethereumConn *ethereum.Connection, lightClientBridge *lightclientbridge.Contract, log *logrus.Entry) *EthereumBeefySource {
+	return &EthereumBeefySource{
+		ethereumConn:      ethereumConn,
+		lightClientBridge: lightClientBridge,
+		log:               log,
+	}
+}
+
+// Subscribe watches the light client bridge contract for new Ethereum blocks and, on each one,
+// filters for NewMMRRoot events it may contain. Each event's BlockNumber is the relay chain block
+// the newly accepted MMR root commits to, so by the time it's reported Ethereum itself has
+// already finalized that commitment.
+func (s *EthereumBeefySource) Subscribe(ctx context.Context) (<-chan BeefyJustification, error) {
+	heads := make(chan *gethTypes.Header)
+	headSub, err := s.ethereumConn.GetClient().SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return nil, err
+	}
+
+	justifications := make(chan BeefyJustification)
+	go func() {
+		defer headSub.Unsubscribe()
+		defer close(justifications)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-headSub.Err():
+				s.log.WithError(err).Error("Ethereum new-head subscription failed")
+				return
+			case head := <-heads:
+				blockNumber := head.Number.Uint64()
+				filterOps := bind.FilterOpts{Start: blockNumber, End: &blockNumber, Context: ctx}
+
+				iter, err := s.lightClientBridge.FilterNewMMRRoot(&filterOps)
+				if err != nil {
+					s.log.WithError(err).Error("Failed to filter NewMMRRoot events")
+					continue
+				}
+				for iter.Next() {
+					event := iter.Event
+					select {
+					case justifications <- BeefyJustification{BlockNumber: event.BlockNumber}:
+					case <-ctx.Done():
+						iter.Close()
+						return
+					}
+				}
+				iter.Close()
+			}
+		}
+	}()
+
+	return justifications, nil
+}
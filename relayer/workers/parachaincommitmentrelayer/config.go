@@ -0,0 +1,16 @@
+package parachaincommitmentrelayer
+
+// ParachainConfig identifies one parachain this relayer instance serves: its ID as registered in
+// the relay chain's Paras.Heads map, and the RPC endpoint of a node for that parachain, used to
+// read its on-chain commitment storage and generate storage proofs against it.
+type ParachainConfig struct {
+	ParachainID uint32 `toml:"parachain_id"`
+	Endpoint    string `toml:"endpoint"`
+}
+
+// Config lists every parachain BeefyListener fans BEEFY commitments out to. A single relayer
+// process can serve several parachains sharing the same relay chain by listing one entry per
+// parachain here, rather than running one process per parachain.
+type Config struct {
+	Parachains []ParachainConfig `toml:"parachains"`
+}
@@ -1,17 +1,42 @@
 package parachaincommitment
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/sirupsen/logrus"
 	"github.com/snowfork/go-substrate-rpc-client/v2/types"
 	"github.com/snowfork/polkadot-ethereum/relayer/contracts/inbound"
+	"github.com/snowfork/polkadot-ethereum/relayer/metrics"
 	"github.com/snowfork/polkadot-ethereum/relayer/substrate"
 	chainTypes "github.com/snowfork/polkadot-ethereum/relayer/substrate"
+	"github.com/snowfork/polkadot-ethereum/relayer/workers/beefyrelayer/store"
 )
 
+// checkpointChain and checkpointListenerName identify this listener's row in
+// the store.Checkpoint table, keyed by (chain, listener_name).
+const (
+	checkpointChain        = "parachain"
+	checkpointListenerName = "parachaincommitment-listener"
+)
+
+// SetMetrics attaches m so catchupMissedCommitments and searchForLostCommitments can report the
+// parachain/Ethereum nonce gap and last-scanned block. Leaving it unset disables reporting.
+func (li *Listener) SetMetrics(m *metrics.Metrics) {
+	li.metrics = m
+}
+
+// SetCheckpointDB attaches the store backing catchupMissedCommitments' last-scanned-block
+// persistence. Leaving it unset makes searchForLostCommitments fall back to scanning all the way
+// back to block zero on every run, matching its previous behaviour.
+func (li *Listener) SetCheckpointDB(checkpointDB *store.Database, dbMessages chan<- store.DatabaseCmd) {
+	li.checkpointDB = checkpointDB
+	li.dbMessages = dbMessages
+}
+
 // Catches up by searching for and relaying all missed commitments before the given block
 func (li *Listener) catchupMissedCommitments(ctx context.Context, latestBlock uint64) error {
 	basicContract, err := inbound.NewContract(common.HexToAddress(
@@ -87,6 +112,11 @@ func (li *Listener) catchupMissedCommitments(ctx context.Context, latestBlock ui
 		"nonce": uint64(paraIncentivizedNonce),
 	}).Info("Checked latest nonce generated by parachain incentivized channel")
 
+	if li.metrics != nil {
+		li.metrics.ParachainNonceGap.WithLabelValues("basic").Set(float64(uint64(paraBasicNonce)) - float64(ethBasicNonce))
+		li.metrics.ParachainNonceGap.WithLabelValues("incentivized").Set(float64(uint64(paraIncentivizedNonce)) - float64(ethIncentivizedNonce))
+	}
+
 	if ethBasicNonce == uint64(paraBasicNonce) && ethIncentivizedNonce == uint64(paraIncentivizedNonce) {
 		return nil
 	}
@@ -101,6 +131,91 @@ func (li *Listener) catchupMissedCommitments(ctx context.Context, latestBlock ui
 	return nil
 }
 
+// maxAncestorSearchDepth bounds findCommonAncestor's backward walk, mirroring
+// beefy-ethereum-listener.go's resumeBlock: a checkpoint referencing a hash the node has no
+// record of at all (rather than just an abandoned fork) fails fast instead of walking all the
+// way to genesis.
+const maxAncestorSearchDepth = 4096
+
+// earliestResumeBlock returns the lowest block number catchupMissedCommitments is allowed to
+// stop scanning at. Previous runs checkpoint the last block they fully scanned, so a restart
+// never re-walks blocks that were already searched for lost commitments - unless the checkpointed
+// block itself is no longer on the canonical parachain (the relayer was stopped mid-reorg), in
+// which case findCommonAncestor walks back to find where the two chains actually diverged, so the
+// reorged gap still gets rescanned.
+func (li *Listener) earliestResumeBlock() uint64 {
+	if li.checkpointDB == nil {
+		return 0
+	}
+	checkpoint, ok := li.checkpointDB.GetCheckpoint(checkpointChain, checkpointListenerName)
+	if !ok {
+		return 0
+	}
+
+	commonAncestor, err := li.findCommonAncestor(checkpoint.LastScannedBlock, checkpoint.LastScannedHash)
+	if err != nil {
+		li.log.WithError(err).Warn("Failed to verify checkpointed block is still canonical, resuming from it unverified")
+		return checkpoint.LastScannedBlock
+	}
+	return commonAncestor
+}
+
+// findCommonAncestor returns the highest block at or below checkpointBlock whose hash still
+// matches the canonical parachain. If checkpointHash is no longer canonical, it walks the
+// abandoned checkpoint block's parent-hash chain backward one block at a time - fetching each
+// stale ancestor's header by hash (GetHeader accepts any hash the node still holds, canonical or
+// not) and comparing it against the canonical hash at the same height (GetBlockHash) - until one
+// matches. That's the true fork point, however many blocks deep the reorg went.
+func (li *Listener) findCommonAncestor(checkpointBlock uint64, checkpointHash common.Hash) (uint64, error) {
+	canonicalHash, err := li.parachainConnection.GetAPI().RPC.Chain.GetBlockHash(checkpointBlock)
+	if err != nil {
+		return 0, fmt.Errorf("fetch canonical block hash at checkpointed block %d: %w", checkpointBlock, err)
+	}
+	if bytes.Equal(canonicalHash[:], checkpointHash[:]) {
+		return checkpointBlock, nil
+	}
+
+	if checkpointBlock == 0 {
+		return 0, nil
+	}
+
+	li.log.WithFields(logrus.Fields{
+		"checkpointBlock": checkpointBlock,
+		"checkpointHash":  checkpointHash.Hex(),
+	}).Warn("Checkpointed block is no longer canonical, walking back to find the common ancestor")
+
+	staleHeader, err := li.parachainConnection.GetAPI().RPC.Chain.GetHeader(types.Hash(checkpointHash))
+	if err != nil {
+		li.log.WithError(err).Warn("Could not fetch the abandoned checkpoint block by hash, rewinding to its parent only")
+		return checkpointBlock - 1, nil
+	}
+
+	ancestorHash := staleHeader.ParentHash
+	for blockNumber := checkpointBlock - 1; checkpointBlock-blockNumber <= maxAncestorSearchDepth; {
+		canonicalAtHeight, err := li.parachainConnection.GetAPI().RPC.Chain.GetBlockHash(blockNumber)
+		if err != nil {
+			return 0, fmt.Errorf("fetch canonical block hash at block %d: %w", blockNumber, err)
+		}
+		if bytes.Equal(canonicalAtHeight[:], ancestorHash[:]) {
+			return blockNumber, nil
+		}
+
+		if blockNumber == 0 {
+			break
+		}
+
+		staleAncestor, err := li.parachainConnection.GetAPI().RPC.Chain.GetHeader(ancestorHash)
+		if err != nil {
+			li.log.WithError(err).WithField("blockNumber", blockNumber).Warn("Could not fetch an abandoned ancestor block by hash, stopping ancestor walk here")
+			return blockNumber, nil
+		}
+		ancestorHash = staleAncestor.ParentHash
+		blockNumber--
+	}
+
+	return 0, fmt.Errorf("no common ancestor found for checkpointed block %d within %d blocks", checkpointBlock, maxAncestorSearchDepth)
+}
+
 func (li *Listener) searchForLostCommitments(ctx context.Context, lastBlockNumber uint64, basicNonceToFind uint64, incentivizedNonceToFind uint64) error {
 	li.log.WithFields(logrus.Fields{
 		"basicNonce":        basicNonceToFind,
@@ -110,11 +225,13 @@ func (li *Listener) searchForLostCommitments(ctx context.Context, lastBlockNumbe
 	basicId := substrate.ChannelID{IsBasic: true}
 	incentivizedId := substrate.ChannelID{IsIncentivized: true}
 
+	earliestBlockNumber := li.earliestResumeBlock()
+
 	currentBlockNumber := lastBlockNumber + 1
 	basicNonceFound := false
 	incentivizedNonceFound := false
 	var digestItems []*chainTypes.AuxiliaryDigestItem
-	for (basicNonceFound == false || incentivizedNonceFound == false) && currentBlockNumber != 0 {
+	for (basicNonceFound == false || incentivizedNonceFound == false) && currentBlockNumber > earliestBlockNumber {
 		currentBlockNumber--
 		li.log.WithFields(logrus.Fields{
 			"blockNumber": currentBlockNumber,
@@ -179,6 +296,23 @@ func (li *Listener) searchForLostCommitments(ctx context.Context, lastBlockNumbe
 		}
 	}
 
+	if li.checkpointDB != nil {
+		scannedToHash, err := li.parachainConnection.GetAPI().RPC.Chain.GetBlockHash(lastBlockNumber)
+		if err != nil {
+			return err
+		}
+		li.dbMessages <- store.NewCheckpointCmd(store.Checkpoint{
+			Chain:            checkpointChain,
+			ListenerName:     checkpointListenerName,
+			LastScannedBlock: lastBlockNumber,
+			LastScannedHash:  common.BytesToHash(scannedToHash[:]),
+		})
+	}
+
+	if li.metrics != nil {
+		li.metrics.BeefyLastProcessedBlock.WithLabelValues(checkpointChain).Set(float64(lastBlockNumber))
+	}
+
 	return nil
 }
 